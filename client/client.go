@@ -17,19 +17,23 @@
 package client
 
 import (
+	"errors"
 	"github.com/SENERGY-Platform/go-base-http-client"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"io"
 	"net/http"
 )
 
 type Client struct {
 	baseClient *base_client.Client
+	httpClient base_client.HTTPClient
 	baseUrl    string
 }
 
 func New(httpClient base_client.HTTPClient, baseUrl string) *Client {
 	return &Client{
 		baseClient: base_client.New(httpClient, customError, model.HeaderRequestID),
+		httpClient: httpClient,
 		baseUrl:    baseUrl,
 	}
 }
@@ -45,3 +49,21 @@ func customError(code int, err error) error {
 	}
 	return err
 }
+
+// execRequestStream returns the response body without reading it, the caller must close it.
+func (c *Client) execRequestStream(req *http.Request) (io.ReadCloser, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		errMsg := string(b)
+		if err != nil || errMsg == "" {
+			errMsg = resp.Status
+		}
+		return nil, customError(resp.StatusCode, errors.New(errMsg))
+	}
+	return resp.Body, nil
+}
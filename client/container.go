@@ -23,7 +23,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 )
@@ -80,6 +82,23 @@ func (c *Client) CreateContainer(ctx context.Context, container model.Container)
 	return c.baseClient.ExecRequestString(req)
 }
 
+func (c *Client) UpdateContainer(ctx context.Context, id string, update model.ContainerUpdate) error {
+	u, err := url.JoinPath(c.baseUrl, model.ContainersPath, id)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, u, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	return c.baseClient.ExecRequestVoid(req)
+}
+
 func (c *Client) StartContainer(ctx context.Context, id string) error {
 	u, err := url.JoinPath(c.baseUrl, model.ContainersPath, id, model.ContainerStartPath)
 	if err != nil {
@@ -116,6 +135,62 @@ func (c *Client) RestartContainer(ctx context.Context, id string) (jobId string,
 	return c.baseClient.ExecRequestString(req)
 }
 
+func (c *Client) PauseContainer(ctx context.Context, id string) error {
+	u, err := url.JoinPath(c.baseUrl, model.ContainersPath, id, model.ContainerPausePath)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, u, nil)
+	if err != nil {
+		return err
+	}
+	return c.baseClient.ExecRequestVoid(req)
+}
+
+func (c *Client) UnpauseContainer(ctx context.Context, id string) error {
+	u, err := url.JoinPath(c.baseUrl, model.ContainersPath, id, model.ContainerUnpausePath)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, u, nil)
+	if err != nil {
+		return err
+	}
+	return c.baseClient.ExecRequestVoid(req)
+}
+
+func (c *Client) ConnectContainerNetwork(ctx context.Context, id string, net model.ContainerNet) error {
+	u, err := url.JoinPath(c.baseUrl, model.ContainersPath, id, model.ContainerNetsPath)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(net)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	return c.baseClient.ExecRequestVoid(req)
+}
+
+func (c *Client) DisconnectContainerNetwork(ctx context.Context, id, netID string, force bool) error {
+	u, err := url.JoinPath(c.baseUrl, model.ContainersPath, id, model.ContainerNetsPath, url.PathEscape(netID))
+	if err != nil {
+		return err
+	}
+	if force {
+		u += "?force=true"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return err
+	}
+	return c.baseClient.ExecRequestVoid(req)
+}
+
 func (c *Client) RemoveContainer(ctx context.Context, id string, force bool) error {
 	u, err := url.JoinPath(c.baseUrl, model.ContainersPath, id)
 	if err != nil {
@@ -132,7 +207,16 @@ func (c *Client) RemoveContainer(ctx context.Context, id string, force bool) err
 }
 
 func (c *Client) GetContainerLog(ctx context.Context, id string, logOptions model.LogFilter) (io.ReadCloser, error) {
-	panic("not implemented")
+	u, err := url.JoinPath(c.baseUrl, model.ContainerLogsPath, id)
+	if err != nil {
+		return nil, err
+	}
+	u += genContainerLogQuery(logOptions)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.execRequestStream(req)
 }
 
 func (c *Client) ContainerExec(ctx context.Context, id string, exeConf model.ExecConfig) (jobId string, err error) {
@@ -152,6 +236,35 @@ func (c *Client) ContainerExec(ctx context.Context, id string, exeConf model.Exe
 	return c.baseClient.ExecRequestString(req)
 }
 
+func (c *Client) GetContainerStats(ctx context.Context, id string) (model.ContainerStats, error) {
+	u, err := url.JoinPath(c.baseUrl, model.ContainersPath, id, model.ContainerStatsPath)
+	if err != nil {
+		return model.ContainerStats{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return model.ContainerStats{}, err
+	}
+	var stats model.ContainerStats
+	err = c.baseClient.ExecRequestJSON(req, &stats)
+	if err != nil {
+		return model.ContainerStats{}, err
+	}
+	return stats, nil
+}
+
+func (c *Client) GetContainerStatsStream(ctx context.Context, id string) (io.ReadCloser, error) {
+	u, err := url.JoinPath(c.baseUrl, model.ContainersPath, id, model.ContainerStatsPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u+"?stream=true", nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.execRequestStream(req)
+}
+
 func genGetContainersQuery(filter model.ContainerFilter) string {
 	var q []string
 	if len(filter.Ids) > 0 {
@@ -171,3 +284,29 @@ func genGetContainersQuery(filter model.ContainerFilter) string {
 	}
 	return ""
 }
+
+func genContainerLogQuery(filter model.LogFilter) string {
+	var q []string
+	if filter.MaxLines > 0 {
+		q = append(q, "max_lines="+strconv.FormatInt(int64(filter.MaxLines), 10))
+	}
+	if !filter.Since.IsZero() {
+		q = append(q, "since="+url.QueryEscape(filter.Since.Format(time.RFC3339Nano)))
+	}
+	if !filter.Until.IsZero() {
+		q = append(q, "until="+url.QueryEscape(filter.Until.Format(time.RFC3339Nano)))
+	}
+	if filter.Follow {
+		q = append(q, "follow=true")
+	}
+	if filter.Stream != "" {
+		q = append(q, "stream="+filter.Stream)
+	}
+	if filter.Format != "" {
+		q = append(q, "format="+filter.Format)
+	}
+	if len(q) > 0 {
+		return "?" + strings.Join(q, "&")
+	}
+	return ""
+}
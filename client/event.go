@@ -0,0 +1,116 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetEvents(ctx context.Context, filter model.EventFilter) (<-chan model.Event, <-chan error) {
+	eventChan := make(chan model.Event)
+	errChan := make(chan error, 1)
+	u, err := url.JoinPath(c.baseUrl, model.EventsPath)
+	if err != nil {
+		errChan <- err
+		close(eventChan)
+		return eventChan, errChan
+	}
+	u += genEventsQuery(filter)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		errChan <- err
+		close(eventChan)
+		return eventChan, errChan
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	go func() {
+		defer close(eventChan)
+		body, err := c.execRequestStream(req)
+		if err != nil {
+			errChan <- err
+			return
+		}
+		defer body.Close()
+		scanner := bufio.NewScanner(body)
+		scanner.Buffer(make([]byte, 0, 65536), 1048576)
+		var data bytes.Buffer
+		var name string
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" {
+				if name == "error" {
+					errChan <- model.NewInternalError(errors.New(data.String()))
+					return
+				}
+				name = ""
+				if data.Len() > 0 {
+					var event model.Event
+					if err := json.Unmarshal(data.Bytes(), &event); err != nil {
+						errChan <- err
+						return
+					}
+					data.Reset()
+					select {
+					case eventChan <- event:
+					case <-ctx.Done():
+						return
+					}
+				}
+				continue
+			}
+			if e, ok := strings.CutPrefix(line, "event:"); ok {
+				name = strings.TrimPrefix(e, " ")
+				continue
+			}
+			if d, ok := strings.CutPrefix(line, "data:"); ok {
+				if data.Len() > 0 {
+					data.WriteByte('\n')
+				}
+				data.WriteString(strings.TrimPrefix(d, " "))
+			}
+		}
+		if err := scanner.Err(); err != nil && ctx.Err() == nil {
+			errChan <- err
+		}
+	}()
+	return eventChan, errChan
+}
+
+func genEventsQuery(filter model.EventFilter) string {
+	var q []string
+	if len(filter.Types) > 0 {
+		q = append(q, "types="+strings.Join(filter.Types, ","))
+	}
+	if len(filter.Ids) > 0 {
+		q = append(q, "ids="+strings.Join(filter.Ids, ","))
+	}
+	if len(filter.Labels) > 0 {
+		q = append(q, "labels="+genLabels(filter.Labels, "=", ","))
+	}
+	if len(q) > 0 {
+		return "?" + strings.Join(q, "&")
+	}
+	return ""
+}
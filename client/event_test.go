@@ -0,0 +1,87 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+)
+
+func TestClient_GetEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantIDs []string
+		wantErr string
+	}{
+		{
+			name:    "events and heartbeats",
+			body:    ": connected\n\nevent:message\ndata:{\"type\":\"container\",\"id\":\"a\"}\n\n: heartbeat\n\nevent:message\ndata:{\"type\":\"image\",\"id\":\"b\"}\n\n",
+			wantIDs: []string{"a", "b"},
+		},
+		{
+			name:    "error event",
+			body:    ": connected\n\nevent:message\ndata:{\"type\":\"container\",\"id\":\"a\"}\n\nevent:error\ndata:engine unavailable\n\n",
+			wantIDs: []string{"a"},
+			wantErr: "engine unavailable",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				_, _ = io.WriteString(w, tc.body)
+			}))
+			defer srv.Close()
+			c := New(http.DefaultClient, srv.URL)
+			eventChan, errChan := c.GetEvents(context.Background(), model.EventFilter{})
+			var ids []string
+			for event := range eventChan {
+				ids = append(ids, event.ID)
+			}
+			if len(ids) != len(tc.wantIDs) {
+				t.Fatalf("expected %v, got %v", tc.wantIDs, ids)
+			}
+			for i := range ids {
+				if ids[i] != tc.wantIDs[i] {
+					t.Errorf("expected %v, got %v", tc.wantIDs, ids)
+				}
+			}
+			var err error
+			select {
+			case err = <-errChan:
+			default:
+			}
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var iErr *model.InternalError
+			if !errors.As(err, &iErr) || err.Error() != tc.wantErr {
+				t.Errorf("expected internal error '%s', got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
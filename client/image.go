@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -62,12 +63,32 @@ func (c *Client) GetImage(ctx context.Context, id string) (model.Image, error) {
 	return image, nil
 }
 
+func (c *Client) GetImageDetails(ctx context.Context, id string) (model.ImageDetails, error) {
+	u, err := url.JoinPath(c.baseUrl, model.ImagesPath, url.PathEscape(id), model.ImageDetailsPath)
+	if err != nil {
+		return model.ImageDetails{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return model.ImageDetails{}, err
+	}
+	var details model.ImageDetails
+	err = c.baseClient.ExecRequestJSON(req, &details)
+	if err != nil {
+		return model.ImageDetails{}, err
+	}
+	return details, nil
+}
+
 func (c *Client) AddImage(ctx context.Context, img string) (jobId string, err error) {
+	return c.AddImageWithOptions(ctx, model.ImageRequest{Image: img})
+}
+
+func (c *Client) AddImageWithOptions(ctx context.Context, imgReq model.ImageRequest) (jobId string, err error) {
 	u, err := url.JoinPath(c.baseUrl, model.ImagesPath)
 	if err != nil {
 		return "", err
 	}
-	imgReq := model.ImageRequest{Image: img}
 	body, err := json.Marshal(imgReq)
 	if err != nil {
 		return "", err
@@ -80,6 +101,28 @@ func (c *Client) AddImage(ctx context.Context, img string) (jobId string, err er
 	return c.baseClient.ExecRequestString(req)
 }
 
+func (c *Client) CheckImageUpdate(ctx context.Context, imgReq model.ImageRequest) (model.ImageUpdateCheck, error) {
+	u, err := url.JoinPath(c.baseUrl, model.ImagesPath, model.ImageUpdateCheckPath)
+	if err != nil {
+		return model.ImageUpdateCheck{}, err
+	}
+	body, err := json.Marshal(imgReq)
+	if err != nil {
+		return model.ImageUpdateCheck{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewBuffer(body))
+	if err != nil {
+		return model.ImageUpdateCheck{}, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	var res model.ImageUpdateCheck
+	err = c.baseClient.ExecRequestJSON(req, &res)
+	if err != nil {
+		return model.ImageUpdateCheck{}, err
+	}
+	return res, nil
+}
+
 func (c *Client) RemoveImage(ctx context.Context, id string) error {
 	u, err := url.JoinPath(c.baseUrl, model.ImagesPath, url.PathEscape(id))
 	if err != nil {
@@ -98,13 +141,76 @@ func genImagesQuery(filter model.ImageFilter) string {
 		q = append(q, "labels="+genLabels(filter.Labels, "=", ","))
 	}
 	if filter.Name != "" {
-		q = append(q, "name="+filter.Name)
+		q = append(q, "name="+url.QueryEscape(filter.Name))
 	}
 	if filter.Tag != "" {
-		q = append(q, "tag="+filter.Tag)
+		q = append(q, "tag="+url.QueryEscape(filter.Tag))
 	}
 	if len(q) > 0 {
 		return "?" + strings.Join(q, "&")
 	}
 	return ""
 }
+
+func (c *Client) TagImage(ctx context.Context, id, repo, tag string) error {
+	u, err := url.JoinPath(c.baseUrl, model.ImagesPath, url.PathEscape(id), model.ImageTagsPath)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(model.ImageTagRequest{Repository: repo, Tag: tag})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	return c.baseClient.ExecRequestVoid(req)
+}
+
+func (c *Client) UntagImage(ctx context.Context, id, repo, tag string) error {
+	u, err := url.JoinPath(c.baseUrl, model.ImagesPath, url.PathEscape(id), model.ImageTagsPath)
+	if err != nil {
+		return err
+	}
+	u += genImageTagQuery(repo, tag)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return err
+	}
+	return c.baseClient.ExecRequestVoid(req)
+}
+
+func genImageTagQuery(repo, tag string) string {
+	q := []string{"repository=" + url.QueryEscape(repo)}
+	if tag != "" {
+		q = append(q, "tag="+url.QueryEscape(tag))
+	}
+	return "?" + strings.Join(q, "&")
+}
+
+func (c *Client) ImportImage(ctx context.Context, r io.Reader) (jobId string, err error) {
+	u, err := url.JoinPath(c.baseUrl, model.ImagesPath, model.ImageImportPath)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, r)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", model.MIMEApplicationTar)
+	return c.baseClient.ExecRequestString(req)
+}
+
+func (c *Client) ExportImage(ctx context.Context, id string) (io.ReadCloser, error) {
+	u, err := url.JoinPath(c.baseUrl, model.ImagesPath, url.PathEscape(id), model.ImageExportPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.execRequestStream(req)
+}
@@ -23,14 +23,15 @@ import (
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
-func (c *Client) GetNetworks(ctx context.Context) ([]model.Network, error) {
+func (c *Client) GetNetworks(ctx context.Context, filter model.NetworkFilter) ([]model.Network, error) {
 	u, err := url.JoinPath(c.baseUrl, model.NetworksPath)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u+genNetworksQuery(filter), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -87,3 +88,26 @@ func (c *Client) RemoveNetwork(ctx context.Context, id string) error {
 	}
 	return c.baseClient.ExecRequestVoid(req)
 }
+
+func genNetworksQuery(filter model.NetworkFilter) string {
+	var q []string
+	if len(filter.Ids) > 0 {
+		q = append(q, "ids="+strings.Join(filter.Ids, ","))
+	}
+	if len(filter.Names) > 0 {
+		q = append(q, "names="+strings.Join(filter.Names, ","))
+	}
+	if filter.Type != "" {
+		q = append(q, "type="+filter.Type)
+	}
+	if len(filter.Labels) > 0 {
+		q = append(q, "labels="+genLabels(filter.Labels, "=", ","))
+	}
+	if filter.SupportedOnly {
+		q = append(q, "supported_only=true")
+	}
+	if len(q) > 0 {
+		return "?" + strings.Join(q, "&")
+	}
+	return ""
+}
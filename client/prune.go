@@ -0,0 +1,75 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) PruneContainers(ctx context.Context, filter model.PruneFilter) (jobId string, err error) {
+	return c.prune(ctx, filter, model.ContainersPath)
+}
+
+func (c *Client) PruneImages(ctx context.Context, filter model.PruneFilter) (jobId string, err error) {
+	return c.prune(ctx, filter, model.ImagesPath)
+}
+
+func (c *Client) PruneNetworks(ctx context.Context, filter model.PruneFilter) (jobId string, err error) {
+	return c.prune(ctx, filter, model.NetworksPath)
+}
+
+func (c *Client) PruneVolumes(ctx context.Context, filter model.PruneFilter) (jobId string, err error) {
+	return c.prune(ctx, filter, model.VolumesPath)
+}
+
+func (c *Client) PruneBuildCache(ctx context.Context, filter model.PruneFilter) (jobId string, err error) {
+	return c.prune(ctx, filter, model.BuildCachePath)
+}
+
+func (c *Client) prune(ctx context.Context, filter model.PruneFilter, p string) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, p, model.PrunePath)
+	if err != nil {
+		return "", err
+	}
+	u += genPruneQuery(filter)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return "", err
+	}
+	return c.baseClient.ExecRequestString(req)
+}
+
+func genPruneQuery(filter model.PruneFilter) string {
+	var q []string
+	if filter.All {
+		q = append(q, "all=true")
+	}
+	if len(filter.Labels) > 0 {
+		q = append(q, "labels="+genLabels(filter.Labels, "=", ","))
+	}
+	if filter.OlderThan != 0 {
+		q = append(q, "older_than="+filter.OlderThan.String())
+	}
+	if len(q) > 0 {
+		return "?" + strings.Join(q, "&")
+	}
+	return ""
+}
@@ -62,6 +62,23 @@ func (c *Client) GetVolume(ctx context.Context, id string) (model.Volume, error)
 	return volume, nil
 }
 
+func (c *Client) GetVolumeWithUsage(ctx context.Context, id string) (model.Volume, error) {
+	u, err := url.JoinPath(c.baseUrl, model.VolumesPath, id)
+	if err != nil {
+		return model.Volume{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u+"?usage=true", nil)
+	if err != nil {
+		return model.Volume{}, err
+	}
+	var volume model.Volume
+	err = c.baseClient.ExecRequestJSON(req, &volume)
+	if err != nil {
+		return model.Volume{}, err
+	}
+	return volume, nil
+}
+
 func (c *Client) CreateVolume(ctx context.Context, vol model.Volume) (string, error) {
 	u, err := url.JoinPath(c.baseUrl, model.VolumesPath)
 	if err != nil {
@@ -102,6 +119,9 @@ func genVolumesQuery(filter model.VolumeFilter) string {
 	if len(filter.Labels) > 0 {
 		q = append(q, "labels="+genLabels(filter.Labels, "=", ","))
 	}
+	if filter.Usage {
+		q = append(q, "usage=true")
+	}
 	if len(q) > 0 {
 		return "?" + strings.Join(q, "&")
 	}
@@ -18,18 +18,24 @@ package docker_hdl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	hdl_util "github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/docker_hdl/util"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/util"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/stdcopy"
 	"io"
 	"strconv"
 	"time"
 )
 
+const execOutputMaxSize = 64 * 1024
+
 func (h *Handler) ListContainers(ctx context.Context, filter model.ContainerFilter) ([]model.Container, error) {
 	var csl []model.Container
 	cl, err := h.client.ContainerList(ctx, container.ListOptions{All: true, Filters: hdl_util.GenContainerFilterArgs(filter)})
@@ -72,6 +78,7 @@ func (h *Handler) ListContainers(ctx context.Context, filter model.ContainerFilt
 				ctr.Mounts = hdl_util.ParseMounts(ci.HostConfig.Mounts)
 			}
 			ctr.Networks = hdl_util.ParseEndpointSettings(ci.NetworkSettings.Networks)
+			ctr.Resources = hdl_util.ParseResources(ci.HostConfig.Resources)
 			strategy, retries := hdl_util.ParseRestartPolicy(ci.HostConfig.RestartPolicy)
 			ctr.RunConfig = model.RunConfig{
 				RestartStrategy: strategy,
@@ -121,6 +128,7 @@ func (h *Handler) ContainerInfo(ctx context.Context, id string) (model.Container
 		ctr.Ports = ports
 	}
 	ctr.Networks = hdl_util.ParseEndpointSettings(c.NetworkSettings.Networks)
+	ctr.Resources = hdl_util.ParseResources(c.HostConfig.Resources)
 	strategy, retries := hdl_util.ParseRestartPolicy(c.HostConfig.RestartPolicy)
 	ctr.RunConfig = model.RunConfig{
 		RestartStrategy: strategy,
@@ -176,19 +184,25 @@ func (h *Handler) ContainerCreate(ctx context.Context, ctrConf model.Container)
 		return "", model.NewInvalidInputError(err)
 	}
 	dvs, err := hdl_util.GenDevices(ctrConf.Devices)
+	if err != nil {
+		return "", model.NewInvalidInputError(err)
+	}
 	rp, err := hdl_util.GenRestartPolicy(ctrConf.RunConfig.RestartStrategy, ctrConf.RunConfig.Retries)
 	if err != nil {
 		return "", model.NewInvalidInputError(err)
 	}
+	rsc, err := hdl_util.GenResources(ctrConf.Resources)
+	if err != nil {
+		return "", model.NewInvalidInputError(err)
+	}
+	rsc.Devices = dvs
+	rsc.DeviceCgroupRules = ctrConf.DeviceCGroupRules
 	hConfig := &container.HostConfig{
 		PortBindings:  portMap,
 		RestartPolicy: rp,
 		AutoRemove:    ctrConf.RunConfig.RemoveAfterRun,
 		Mounts:        mts,
-		Resources: container.Resources{
-			Devices:           dvs,
-			DeviceCgroupRules: ctrConf.DeviceCGroupRules,
-		},
+		Resources:     rsc,
 	}
 	if h.ctrLogConf.Driver != "" {
 		var cMap map[string]string
@@ -210,12 +224,19 @@ func (h *Handler) ContainerCreate(ctx context.Context, ctrConf model.Container)
 	if err != nil {
 		return "", model.NewInvalidInputError(err)
 	}
+	endptSettings := make([]*network.EndpointSettings, len(ctrConf.Networks))
+	for i, n := range ctrConf.Networks {
+		if endptSettings[i], err = hdl_util.GenEndpointSettings(n); err != nil {
+			return "", model.NewInvalidInputError(err)
+		}
+		if err = h.checkContainerNetAddr(ctx, "", n.Name, n); err != nil {
+			return "", err
+		}
+	}
 	var nConfig *network.NetworkingConfig
 	if len(ctrConf.Networks) > 0 {
 		nConfig = &network.NetworkingConfig{EndpointsConfig: map[string]*network.EndpointSettings{
-			ctrConf.Networks[0].Name: {
-				Aliases: ctrConf.Networks[0].DomainNames,
-			},
+			ctrConf.Networks[0].Name: endptSettings[0],
 		}}
 	}
 	res, err := h.client.ContainerCreate(ctx, cConfig, hConfig, nConfig, nil, ctrConf.Name)
@@ -224,14 +245,15 @@ func (h *Handler) ContainerCreate(ctx context.Context, ctrConf model.Container)
 	}
 	if len(ctrConf.Networks) > 1 {
 		for i := 1; i < len(ctrConf.Networks); i++ {
-			err := h.client.NetworkConnect(ctx, ctrConf.Networks[i].Name, res.ID, &network.EndpointSettings{
-				Aliases: ctrConf.Networks[i].DomainNames,
-			})
+			err := h.client.NetworkConnect(ctx, ctrConf.Networks[i].Name, res.ID, endptSettings[i])
 			if err != nil {
 				err2 := h.ContainerRemove(ctx, res.ID, true)
 				if err2 != nil {
 					util.Logger.Errorf("removing container '%s' failed: %s", ctrConf.Name, err2)
 				}
+				if errdefs.IsInvalidParameter(err) || errdefs.IsConflict(err) || errdefs.IsForbidden(err) {
+					return "", model.NewInvalidInputError(err)
+				}
 				return "", model.NewInternalError(err)
 			}
 		}
@@ -242,6 +264,43 @@ func (h *Handler) ContainerCreate(ctx context.Context, ctrConf model.Container)
 	return res.ID, nil
 }
 
+func (h *Handler) ContainerUpdate(ctx context.Context, id string, update model.ContainerUpdate) error {
+	uConfig := container.UpdateConfig{}
+	if update.RestartStrategy != nil {
+		rp, err := hdl_util.GenRestartPolicy(*update.RestartStrategy, update.Retries)
+		if err != nil {
+			return model.NewInvalidInputError(err)
+		}
+		uConfig.RestartPolicy = rp
+	} else if update.Retries != nil {
+		return model.NewInvalidInputError(errors.New("number of retries requires a restart strategy"))
+	}
+	if update.Resources != nil {
+		if len(update.Resources.Ulimits) > 0 {
+			return model.NewInvalidInputError(errors.New("ulimits can not be updated"))
+		}
+		res, err := hdl_util.GenResources(*update.Resources)
+		if err != nil {
+			return model.NewInvalidInputError(err)
+		}
+		uConfig.Resources = res
+	}
+	res, err := h.client.ContainerUpdate(ctx, id, uConfig)
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return model.NewNotFoundError(err)
+		}
+		if errdefs.IsInvalidParameter(err) || errdefs.IsConflict(err) {
+			return model.NewInvalidInputError(err)
+		}
+		return model.NewInternalError(err)
+	}
+	if len(res.Warnings) > 0 {
+		util.Logger.Warningf("encountered warnings during update of container '%s': %s", id, res.Warnings)
+	}
+	return nil
+}
+
 func (h *Handler) ContainerRemove(ctx context.Context, id string, force bool) error {
 	if err := h.client.ContainerRemove(ctx, id, container.RemoveOptions{Force: force}); err != nil {
 		if client.IsErrNotFound(err) {
@@ -282,10 +341,88 @@ func (h *Handler) ContainerRestart(ctx context.Context, id string) error {
 	return nil
 }
 
+func (h *Handler) ContainerNetConnect(ctx context.Context, id string, net model.ContainerNet) error {
+	netID := net.Name
+	if netID == "" {
+		netID = net.ID
+	}
+	if netID == "" {
+		return model.NewInvalidInputError(errors.New("missing network name or ID"))
+	}
+	endptSettings, err := hdl_util.GenEndpointSettings(net)
+	if err != nil {
+		return model.NewInvalidInputError(err)
+	}
+	if err = h.checkContainerNetAddr(ctx, id, netID, net); err != nil {
+		return err
+	}
+	if err = h.client.NetworkConnect(ctx, netID, id, endptSettings); err != nil {
+		if client.IsErrNotFound(err) {
+			return model.NewNotFoundError(err)
+		}
+		if errdefs.IsInvalidParameter(err) || errdefs.IsConflict(err) || errdefs.IsForbidden(err) {
+			return model.NewInvalidInputError(err)
+		}
+		return model.NewInternalError(err)
+	}
+	return nil
+}
+
+func (h *Handler) ContainerNetDisconnect(ctx context.Context, id, netID string, force bool) error {
+	if err := h.client.NetworkDisconnect(ctx, netID, id, force); err != nil {
+		if client.IsErrNotFound(err) {
+			return model.NewNotFoundError(err)
+		}
+		if errdefs.IsInvalidParameter(err) || errdefs.IsConflict(err) || errdefs.IsForbidden(err) {
+			return model.NewInvalidInputError(err)
+		}
+		return model.NewInternalError(err)
+	}
+	return nil
+}
+
+func (h *Handler) ContainerPause(ctx context.Context, id string) error {
+	if err := h.client.ContainerPause(ctx, id); err != nil {
+		if client.IsErrNotFound(err) {
+			return model.NewNotFoundError(err)
+		}
+		if errdefs.IsConflict(err) {
+			return model.NewInvalidInputError(err)
+		}
+		return model.NewInternalError(err)
+	}
+	return nil
+}
+
+func (h *Handler) ContainerUnpause(ctx context.Context, id string) error {
+	if err := h.client.ContainerUnpause(ctx, id); err != nil {
+		if client.IsErrNotFound(err) {
+			return model.NewNotFoundError(err)
+		}
+		if errdefs.IsConflict(err) {
+			return model.NewInvalidInputError(err)
+		}
+		return model.NewInternalError(err)
+	}
+	return nil
+}
+
 func (h *Handler) ContainerLog(ctx context.Context, id string, logOpt model.LogFilter) (io.ReadCloser, error) {
+	if logOpt.Stream != "" {
+		if _, ok := model.LogStreamMap[logOpt.Stream]; !ok {
+			return nil, model.NewInvalidInputError(fmt.Errorf("invalid log stream '%s'", logOpt.Stream))
+		}
+	}
+	if logOpt.Format != "" {
+		if _, ok := model.LogFormatMap[logOpt.Format]; !ok {
+			return nil, model.NewInvalidInputError(fmt.Errorf("invalid log format '%s'", logOpt.Format))
+		}
+	}
 	clo := container.LogsOptions{
-		ShowStdout: true,
-		ShowStderr: true,
+		ShowStdout: logOpt.Stream != model.StderrStream,
+		ShowStderr: logOpt.Stream != model.StdoutStream,
+		Follow:     logOpt.Follow,
+		Timestamps: logOpt.Format == model.NDJSONLogFormat,
 	}
 	if !logOpt.Since.IsZero() {
 		clo.Since = logOpt.Since.Format(time.RFC3339Nano)
@@ -303,10 +440,13 @@ func (h *Handler) ContainerLog(ctx context.Context, id string, logOpt model.LogF
 		}
 		return nil, model.NewInternalError(err)
 	}
+	if logOpt.Format == model.NDJSONLogFormat {
+		return &hdl_util.NDJSONWrapper{RCWrapper: &hdl_util.RCWrapper{ReadCloser: rc}}, nil
+	}
 	return &hdl_util.RCWrapper{ReadCloser: rc}, nil
 }
 
-func (h *Handler) ContainerExec(ctx context.Context, id string, execOpt model.ExecConfig) error {
+func (h *Handler) ContainerExec(ctx context.Context, id string, execOpt model.ExecConfig) (model.ExecResult, error) {
 	eConf, err := h.client.ContainerExecCreate(ctx, id, container.ExecOptions{
 		Tty:          execOpt.Tty,
 		AttachStderr: true,
@@ -316,25 +456,52 @@ func (h *Handler) ContainerExec(ctx context.Context, id string, execOpt model.Ex
 		Cmd:          execOpt.Cmd,
 	})
 	if err != nil {
-		return model.NewInternalError(err)
+		if client.IsErrNotFound(err) {
+			return model.ExecResult{}, model.NewNotFoundError(err)
+		}
+		return model.ExecResult{}, model.NewInternalError(err)
 	}
+	started := time.Now().UTC()
 	eAttach, err := h.client.ContainerExecAttach(ctx, eConf.ID, container.ExecAttachOptions{Tty: execOpt.Tty})
 	if err != nil {
-		return model.NewInternalError(err)
+		return model.ExecResult{}, model.NewInternalError(err)
 	}
 	defer eAttach.Close()
-	eRes, err := h.awaitContainerExec(ctx, eConf.ID, time.Millisecond*250)
-	if err != nil {
-		return model.NewInternalError(err)
-	}
-	if eRes.ExitCode > 0 {
-		bytes, err := io.ReadAll(eAttach.Reader)
+	stdout := hdl_util.NewLimitedBuffer(execOutputMaxSize)
+	stderr := hdl_util.NewLimitedBuffer(execOutputMaxSize)
+	cErr := make(chan error, 1)
+	go func() {
+		var err error
+		if execOpt.Tty {
+			_, err = io.Copy(stdout, eAttach.Reader)
+		} else {
+			_, err = stdcopy.StdCopy(stdout, stderr, eAttach.Reader)
+		}
+		cErr <- err
+	}()
+	select {
+	case <-ctx.Done():
+		eAttach.Close()
+		return model.ExecResult{}, model.NewInternalError(ctx.Err())
+	case err = <-cErr:
 		if err != nil {
-			return model.NewInternalError(err)
+			return model.ExecResult{}, model.NewInternalError(err)
 		}
-		return model.NewInternalError(errors.New(string(bytes)))
 	}
-	return nil
+	eRes, err := h.awaitContainerExec(ctx, eConf.ID, time.Millisecond*250)
+	if err != nil {
+		return model.ExecResult{}, model.NewInternalError(err)
+	}
+	res := model.ExecResult{
+		ExitCode:        eRes.ExitCode,
+		Stdout:          stdout.String(),
+		StdoutTruncated: stdout.Truncated(),
+		Stderr:          stderr.String(),
+		StderrTruncated: stderr.Truncated(),
+		Started:         started,
+		Finished:        time.Now().UTC(),
+	}
+	return res, nil
 }
 
 func (h *Handler) awaitContainerExec(ctx context.Context, execID string, delay time.Duration) (container.ExecInspect, error) {
@@ -355,3 +522,30 @@ func (h *Handler) awaitContainerExec(ctx context.Context, execID string, delay t
 		}
 	}
 }
+
+func (h *Handler) ContainerStats(ctx context.Context, id string) (model.ContainerStats, error) {
+	res, err := h.client.ContainerStats(ctx, id, false)
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return model.ContainerStats{}, model.NewNotFoundError(err)
+		}
+		return model.ContainerStats{}, model.NewInternalError(err)
+	}
+	defer res.Body.Close()
+	var s container.StatsResponse
+	if err = json.NewDecoder(res.Body).Decode(&s); err != nil {
+		return model.ContainerStats{}, model.NewInternalError(err)
+	}
+	return hdl_util.ParseContainerStats(s), nil
+}
+
+func (h *Handler) ContainerStatsStream(ctx context.Context, id string) (io.ReadCloser, error) {
+	res, err := h.client.ContainerStats(ctx, id, true)
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return nil, model.NewNotFoundError(err)
+		}
+		return nil, model.NewInternalError(err)
+	}
+	return &hdl_util.StatsWrapper{ReadCloser: res.Body}, nil
+}
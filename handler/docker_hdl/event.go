@@ -0,0 +1,80 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package docker_hdl
+
+import (
+	"context"
+	"fmt"
+	hdl_util "github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/docker_hdl/util"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/docker/docker/api/types/events"
+)
+
+func (h *Handler) Events(ctx context.Context, filter model.EventFilter) (<-chan model.Event, <-chan error) {
+	eventChan := make(chan model.Event)
+	errChan := make(chan error, 1)
+	for _, t := range filter.Types {
+		if _, ok := model.EventTypeMap[t]; !ok {
+			errChan <- model.NewInvalidInputError(fmt.Errorf("invalid event type '%s'", t))
+			close(eventChan)
+			return eventChan, errChan
+		}
+	}
+	ids := make(map[string]struct{})
+	for _, id := range filter.Ids {
+		ids[id] = struct{}{}
+	}
+	msgChan, mErrChan := h.client.Events(ctx, events.ListOptions{Filters: hdl_util.GenEventFilterArgs(filter)})
+	go func() {
+		defer close(eventChan)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-mErrChan:
+				if err != nil && ctx.Err() == nil {
+					errChan <- model.NewInternalError(err)
+				}
+				return
+			case msg := <-msgChan:
+				event, ok := hdl_util.ParseEvent(msg)
+				if !ok || !inIds(ids, event) {
+					continue
+				}
+				select {
+				case eventChan <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return eventChan, errChan
+}
+
+func inIds(ids map[string]struct{}, event model.Event) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	if _, ok := ids[event.ID]; ok {
+		return true
+	}
+	if _, ok := ids[event.Name]; ok && event.Name != "" {
+		return true
+	}
+	return false
+}
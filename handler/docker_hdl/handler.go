@@ -19,6 +19,8 @@ package docker_hdl
 import (
 	"context"
 	"errors"
+	hdl_util "github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/docker_hdl/util"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/util"
 	"github.com/docker/docker/client"
 	"time"
@@ -31,17 +33,23 @@ type ContainerLogConf struct {
 }
 
 type Handler struct {
-	client     *client.Client
-	ctrLogConf ContainerLogConf
+	client        *client.Client
+	ctrLogConf    ContainerLogConf
+	registryAuths map[string]model.RegistryAuth
 }
 
-func New(c *client.Client, ctrLogConf ContainerLogConf) (*Handler, error) {
+func New(c *client.Client, ctrLogConf ContainerLogConf, registryAuths map[string]model.RegistryAuth) (*Handler, error) {
 	if ctrLogConf.Driver != "" && !isValidLoggingDriver(ctrLogConf.Driver) {
 		return nil, errors.New("invalid logging driver: " + ctrLogConf.Driver)
 	}
+	regAuths := make(map[string]model.RegistryAuth)
+	for host, auth := range registryAuths {
+		regAuths[hdl_util.NormalizeRegistryHost(host)] = auth
+	}
 	return &Handler{
-		client:     c,
-		ctrLogConf: ctrLogConf,
+		client:        c,
+		ctrLogConf:    ctrLogConf,
+		registryAuths: regAuths,
 	}, nil
 }
 
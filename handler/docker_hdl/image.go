@@ -20,24 +20,37 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	hdl_util "github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/docker_hdl/util"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/util"
-	"github.com/docker/docker/api/types/filters"
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/registry"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"io"
-	"strings"
+	"slices"
 )
 
 func (h *Handler) ListImages(ctx context.Context, filter model.ImageFilter) ([]model.Image, error) {
 	var images []model.Image
+	var refFilter *hdl_util.ImageRefFilter
+	if filter.Name != "" {
+		f, err := hdl_util.NewImageRefFilter(filter.Name, filter.Tag)
+		if err != nil {
+			return nil, model.NewInvalidInputError(err)
+		}
+		refFilter = &f
+	}
 	il, err := h.client.ImageList(ctx, image.ListOptions{Filters: hdl_util.GenImageFilterArgs(filter)})
 	if err != nil {
 		return images, model.NewInternalError(err)
 	}
 	for _, is := range il {
-		if filter.Name != "" && !inTags(is.RepoTags, filter.Name, filter.Tag) {
+		if refFilter != nil && !refFilter.Match(is.RepoTags, is.RepoDigests) {
 			continue
 		}
 		img := model.Image{
@@ -64,30 +77,92 @@ func (h *Handler) ListImages(ctx context.Context, filter model.ImageFilter) ([]m
 }
 
 func (h *Handler) ImageInfo(ctx context.Context, id string) (model.Image, error) {
-	img := model.Image{}
+	i, err := h.imageInspect(ctx, id)
+	if err != nil {
+		return model.Image{}, err
+	}
+	return newImage(i), nil
+}
+
+func (h *Handler) ImageDetails(ctx context.Context, id string) (model.ImageDetails, error) {
+	i, err := h.imageInspect(ctx, id)
+	if err != nil {
+		return model.ImageDetails{}, err
+	}
+	hl, err := h.client.ImageHistory(ctx, i.ID)
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return model.ImageDetails{}, model.NewNotFoundError(err)
+		}
+		return model.ImageDetails{}, model.NewInternalError(err)
+	}
+	details := model.ImageDetails{
+		Image:   newImage(i),
+		OS:      i.Os,
+		Variant: i.Variant,
+		History: hdl_util.ParseImageHistory(hl),
+	}
+	if i.Config != nil {
+		details.Entrypoint = i.Config.Entrypoint
+		details.Cmd = i.Config.Cmd
+		details.WorkingDir = i.Config.WorkingDir
+		details.User = i.Config.User
+		details.EnvVars = hdl_util.ParseEnv(i.Config.Env)
+		for v := range i.Config.Volumes {
+			details.Volumes = append(details.Volumes, v)
+		}
+		slices.Sort(details.Volumes)
+		if details.Ports, err = hdl_util.ParsePortSetAndMap(i.Config.ExposedPorts, nil); err != nil {
+			return model.ImageDetails{}, model.NewInternalError(err)
+		}
+	}
+	return details, nil
+}
+
+func (h *Handler) imageInspect(ctx context.Context, id string) (types.ImageInspect, error) {
 	i, _, err := h.client.ImageInspectWithRaw(ctx, id)
 	if err != nil {
 		if client.IsErrNotFound(err) {
-			return model.Image{}, model.NewNotFoundError(err)
+			return types.ImageInspect{}, model.NewNotFoundError(err)
+		}
+		if errdefs.IsInvalidParameter(err) {
+			return types.ImageInspect{}, model.NewInvalidInputError(err)
 		}
-		return model.Image{}, model.NewInternalError(err)
+		return types.ImageInspect{}, model.NewInternalError(err)
 	}
+	return i, nil
+}
+
+func newImage(i types.ImageInspect) model.Image {
+	img := model.Image{}
 	img.ID = i.ID
 	img.Size = i.Size
 	img.Arch = i.Architecture
 	img.Tags = i.RepoTags
 	img.Digests = i.RepoDigests
-	img.Labels = i.Config.Labels
+	if i.Config != nil {
+		img.Labels = i.Config.Labels
+	}
 	if ti, err := hdl_util.ParseTimestamp(i.Created); err != nil {
 		util.Logger.Errorf("parsing created timestamp for image '%s' failed: %s", i.ID, err)
 	} else {
 		img.Created = ti.UTC()
 	}
-	return img, nil
+	return img
 }
 
-func (h *Handler) ImagePull(ctx context.Context, id string) error {
-	rc, err := h.client.ImagePull(ctx, id, image.PullOptions{})
+func (h *Handler) ImagePull(ctx context.Context, req model.ImageRequest, progressFunc func(model.ImagePullProgress)) error {
+	id := req.Image
+	if req.Platform != "" {
+		if _, err := hdl_util.ParsePlatform(req.Platform); err != nil {
+			return model.NewInvalidInputError(err)
+		}
+	}
+	regAuth, err := h.getRegistryAuth(id, req.Auth)
+	if err != nil {
+		return err
+	}
+	rc, err := h.client.ImagePull(ctx, id, image.PullOptions{RegistryAuth: regAuth, Platform: req.Platform})
 	if err != nil {
 		if client.IsErrNotFound(err) {
 			return model.NewNotFoundError(err)
@@ -97,15 +172,22 @@ func (h *Handler) ImagePull(ctx context.Context, id string) error {
 	defer rc.Close()
 	jd := json.NewDecoder(rc)
 	var msg hdl_util.ImgPullResp
+	var progress hdl_util.ImgPullProgress
 	for {
-		if err := jd.Decode(&msg); err != nil {
+		var m hdl_util.ImgPullResp
+		if err := jd.Decode(&m); err != nil {
 			if err == io.EOF {
 				break
 			} else {
 				return model.NewInternalError(err)
 			}
 		}
+		msg = m
 		util.Logger.Debugf("pulling image '%s': %s", id, msg)
+		prg := progress.Update(msg)
+		if progressFunc != nil {
+			progressFunc(prg)
+		}
 	}
 	if msg.Message != "" {
 		return model.NewInternalError(errors.New(msg.Message))
@@ -113,6 +195,72 @@ func (h *Handler) ImagePull(ctx context.Context, id string) error {
 	return nil
 }
 
+func (h *Handler) ImageCheckUpdate(ctx context.Context, req model.ImageRequest) (model.ImageUpdateCheck, error) {
+	named, err := reference.ParseNormalizedNamed(req.Image)
+	if err != nil {
+		return model.ImageUpdateCheck{}, model.NewInvalidInputError(err)
+	}
+	if _, ok := named.(reference.Digested); ok {
+		return model.ImageUpdateCheck{}, model.NewInvalidInputError(fmt.Errorf("image reference '%s' must not contain a digest", req.Image))
+	}
+	var platform *ocispec.Platform
+	if req.Platform != "" {
+		p, err := hdl_util.ParsePlatform(req.Platform)
+		if err != nil {
+			return model.ImageUpdateCheck{}, model.NewInvalidInputError(err)
+		}
+		platform = &p
+	}
+	named = reference.TagNameOnly(named)
+	ref := reference.FamiliarString(named)
+	regAuth, err := h.getRegistryAuth(ref, req.Auth)
+	if err != nil {
+		return model.ImageUpdateCheck{}, err
+	}
+	di, err := h.client.DistributionInspect(ctx, ref, regAuth)
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return model.ImageUpdateCheck{}, model.NewNotFoundError(err)
+		}
+		if errdefs.IsUnauthorized(err) || errdefs.IsForbidden(err) {
+			return model.ImageUpdateCheck{}, model.NewInvalidInputError(err)
+		}
+		return model.ImageUpdateCheck{}, model.NewInternalError(err)
+	}
+	if platform != nil && !slices.ContainsFunc(di.Platforms, func(p ocispec.Platform) bool { return hdl_util.MatchPlatform(*platform, p) }) {
+		return model.ImageUpdateCheck{}, model.NewNotFoundError(fmt.Errorf("platform '%s' not available for image '%s'", req.Platform, ref))
+	}
+	res := model.ImageUpdateCheck{
+		Image:        ref,
+		Status:       model.ImageNotLocal,
+		RemoteDigest: di.Descriptor.Digest.String(),
+	}
+	i, _, err := h.client.ImageInspectWithRaw(ctx, ref)
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return res, nil
+		}
+		return model.ImageUpdateCheck{}, model.NewInternalError(err)
+	}
+	res.Status = model.ImageUpdateAvailable
+	for _, s := range i.RepoDigests {
+		if r, err := reference.ParseNormalizedNamed(s); err == nil && r.Name() == named.Name() {
+			if digested, ok := r.(reference.Digested); ok {
+				res.LocalDigest = digested.Digest().String()
+				if res.LocalDigest == res.RemoteDigest {
+					res.Status = model.ImageUpToDate
+					break
+				}
+			}
+		}
+	}
+	// the local image must be pulled again if it was pulled for a different platform
+	if platform != nil && !hdl_util.MatchPlatform(*platform, ocispec.Platform{OS: i.Os, Architecture: i.Architecture, Variant: i.Variant}) {
+		res.Status = model.ImageUpdateAvailable
+	}
+	return res, nil
+}
+
 func (h *Handler) ImageRemove(ctx context.Context, id string) error {
 	if _, err := h.client.ImageRemove(ctx, id, image.RemoveOptions{}); err != nil {
 		if client.IsErrNotFound(err) {
@@ -123,25 +271,121 @@ func (h *Handler) ImageRemove(ctx context.Context, id string) error {
 	return nil
 }
 
-func (h *Handler) PruneImages(ctx context.Context) error {
-	_, err := h.client.ImagesPrune(ctx, filters.Args{})
-	return err
+func (h *Handler) ImageTag(ctx context.Context, id, repo, tag string) error {
+	ref, err := hdl_util.GenImageRef(repo, tag)
+	if err != nil {
+		return model.NewInvalidInputError(err)
+	}
+	if err = h.client.ImageTag(ctx, id, ref); err != nil {
+		if client.IsErrNotFound(err) {
+			return model.NewNotFoundError(err)
+		}
+		if errdefs.IsInvalidParameter(err) {
+			return model.NewInvalidInputError(err)
+		}
+		return model.NewInternalError(err)
+	}
+	return nil
 }
 
-func inTags(list []string, name, tag string) bool {
-	if tag != "" {
-		name += ":" + tag
-		for _, item := range list {
-			if item == name {
-				return true
-			}
+func (h *Handler) ImageUntag(ctx context.Context, id, repo, tag string) error {
+	ref, err := hdl_util.GenImageRef(repo, tag)
+	if err != nil {
+		return model.NewInvalidInputError(err)
+	}
+	i, _, err := h.client.ImageInspectWithRaw(ctx, id)
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return model.NewNotFoundError(err)
 		}
-	} else {
-		for _, item := range list {
-			if strings.HasPrefix(item, name) {
-				return true
+		return model.NewInternalError(err)
+	}
+	if !slices.Contains(i.RepoTags, ref) {
+		return model.NewNotFoundError(fmt.Errorf("image '%s' has no tag '%s'", id, ref))
+	}
+	if len(i.RepoTags) < 2 {
+		return model.NewInvalidInputError(fmt.Errorf("can't remove last tag '%s' of image '%s'", ref, id))
+	}
+	if _, err = h.client.ImageRemove(ctx, ref, image.RemoveOptions{}); err != nil {
+		if client.IsErrNotFound(err) {
+			return model.NewNotFoundError(err)
+		}
+		return model.NewInternalError(err)
+	}
+	return nil
+}
+
+func (h *Handler) ImageLoad(ctx context.Context, r io.Reader) ([]string, error) {
+	res, err := h.client.ImageLoad(ctx, r, true)
+	if err != nil {
+		if errdefs.IsInvalidParameter(err) {
+			return nil, model.NewInvalidInputError(err)
+		}
+		return nil, model.NewInternalError(err)
+	}
+	defer res.Body.Close()
+	var images []string
+	jd := json.NewDecoder(res.Body)
+	for {
+		var msg hdl_util.ImgLoadResp
+		if err := jd.Decode(&msg); err != nil {
+			if err == io.EOF {
+				break
 			}
+			return nil, model.NewInternalError(err)
+		}
+		if msg.Error != "" {
+			return nil, model.NewInternalError(errors.New(msg.Error))
+		}
+		util.Logger.Debugf("loading image: %s", msg)
+		if img := msg.Image(); img != "" {
+			images = append(images, img)
+		}
+	}
+	return images, nil
+}
+
+func (h *Handler) ImageSave(ctx context.Context, id string) (io.ReadCloser, error) {
+	if _, _, err := h.client.ImageInspectWithRaw(ctx, id); err != nil {
+		if client.IsErrNotFound(err) {
+			return nil, model.NewNotFoundError(err)
 		}
+		return nil, model.NewInternalError(err)
+	}
+	rc, err := h.client.ImageSave(ctx, []string{id})
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return nil, model.NewNotFoundError(err)
+		}
+		return nil, model.NewInternalError(err)
+	}
+	return rc, nil
+}
+
+func (h *Handler) getRegistryAuth(ref string, auth *model.RegistryAuth) (string, error) {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return "", model.NewInvalidInputError(err)
+	}
+	domain := reference.Domain(named)
+	if auth == nil {
+		a, ok := h.registryAuths[domain]
+		if !ok {
+			return "", nil
+		}
+		auth = &a
+	}
+	if auth.IdentityToken == "" && auth.Username == "" {
+		return "", model.NewInvalidInputError(fmt.Errorf("missing credentials for registry '%s'", domain))
+	}
+	regAuth, err := registry.EncodeAuthConfig(registry.AuthConfig{
+		Username:      auth.Username,
+		Password:      auth.Password,
+		IdentityToken: auth.IdentityToken,
+		ServerAddress: domain,
+	})
+	if err != nil {
+		return "", model.NewInternalError(err)
 	}
-	return false
+	return regAuth, nil
 }
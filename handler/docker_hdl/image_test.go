@@ -0,0 +1,181 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package docker_hdl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/client"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// testImageEngine serves distribution and image inspect requests, the registry is represented by the distribution map.
+type testImageEngine struct {
+	distribution map[string]registry.DistributionInspect
+	images       map[string]types.ImageInspect
+}
+
+func (e *testImageEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var res any
+	var ok bool
+	_, p, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if ref, found := strings.CutPrefix(p, "distribution/"); found {
+		res, ok = e.distribution[strings.TrimSuffix(ref, "/json")]
+	} else if ref, found = strings.CutPrefix(p, "images/"); found {
+		res, ok = e.images[strings.TrimSuffix(ref, "/json")]
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not found"}`))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func TestHandler_ImageCheckUpdate(t *testing.T) {
+	const indexDigest = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	const otherDigest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	distribution := map[string]registry.DistributionInspect{
+		"nginx:latest": {
+			Descriptor: ocispec.Descriptor{MediaType: ocispec.MediaTypeImageIndex, Digest: indexDigest},
+			Platforms: []ocispec.Platform{
+				{OS: "linux", Architecture: "amd64"},
+				{OS: "linux", Architecture: "arm64"},
+				{OS: "linux", Architecture: "arm", Variant: "v7"},
+			},
+		},
+	}
+	isInvalidInput := func(err error) bool {
+		var e *model.InvalidInputError
+		return errors.As(err, &e)
+	}
+	isNotFound := func(err error) bool {
+		var e *model.NotFoundError
+		return errors.As(err, &e)
+	}
+	tests := []struct {
+		name     string
+		platform string
+		image    *types.ImageInspect
+		want     model.ImageUpdateStatus
+		wantErr  func(error) bool
+	}{
+		{
+			name:  "up to date",
+			image: &types.ImageInspect{Architecture: "amd64", Os: "linux", RepoDigests: []string{"nginx@" + indexDigest}},
+			want:  model.ImageUpToDate,
+		},
+		{
+			name:  "update available",
+			image: &types.ImageInspect{Architecture: "amd64", Os: "linux", RepoDigests: []string{"nginx@" + otherDigest}},
+			want:  model.ImageUpdateAvailable,
+		},
+		{
+			name: "not local",
+			want: model.ImageNotLocal,
+		},
+		{
+			name:     "arm64 v8 against empty variant",
+			platform: "linux/arm64/v8",
+			image:    &types.ImageInspect{Architecture: "arm64", Os: "linux", RepoDigests: []string{"nginx@" + indexDigest}},
+			want:     model.ImageUpToDate,
+		},
+		{
+			name:     "arm against v7",
+			platform: "linux/arm",
+			image:    &types.ImageInspect{Architecture: "arm", Variant: "v7", Os: "linux", RepoDigests: []string{"nginx@" + indexDigest}},
+			want:     model.ImageUpToDate,
+		},
+		{
+			name:     "local image of other platform",
+			platform: "linux/arm64",
+			image:    &types.ImageInspect{Architecture: "amd64", Os: "linux", RepoDigests: []string{"nginx@" + indexDigest}},
+			want:     model.ImageUpdateAvailable,
+		},
+		{
+			name:     "platform not available",
+			platform: "linux/s390x",
+			wantErr:  isNotFound,
+		},
+		{
+			name:     "invalid platform",
+			platform: "linux",
+			wantErr:  isInvalidInput,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &testImageEngine{distribution: distribution, images: make(map[string]types.ImageInspect)}
+			if tc.image != nil {
+				e.images["nginx:latest"] = *tc.image
+			}
+			srv := httptest.NewServer(e)
+			defer srv.Close()
+			c, err := client.NewClientWithOpts(client.WithHost("tcp://"+srv.Listener.Addr().String()), client.WithVersion("1.47"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Close()
+			h := &Handler{client: c}
+			got, err := h.ImageCheckUpdate(context.Background(), model.ImageRequest{Image: "nginx", Platform: tc.platform})
+			if tc.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				if !tc.wantErr(err) {
+					t.Errorf("unexpected error type %T: %s", err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != tc.want {
+				t.Errorf("expected status '%s', got '%s'", tc.want, got.Status)
+			}
+			if got.RemoteDigest != indexDigest {
+				t.Errorf("expected remote digest '%s', got '%s'", indexDigest, got.RemoteDigest)
+			}
+		})
+	}
+}
+
+func TestHandler_ImageCheckUpdate_NotFound(t *testing.T) {
+	srv := httptest.NewServer(&testImageEngine{})
+	defer srv.Close()
+	c, err := client.NewClientWithOpts(client.WithHost("tcp://"+srv.Listener.Addr().String()), client.WithVersion("1.47"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	h := &Handler{client: c}
+	_, err = h.ImageCheckUpdate(context.Background(), model.ImageRequest{Image: "nginx"})
+	var nfErr *model.NotFoundError
+	if !errors.As(err, &nfErr) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
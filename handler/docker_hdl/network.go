@@ -22,33 +22,43 @@ import (
 	hdl_util "github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/docker_hdl/util"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/util"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
 )
 
-func (h *Handler) ListNetworks(ctx context.Context) ([]model.Network, error) {
+func (h *Handler) ListNetworks(ctx context.Context, filter model.NetworkFilter) ([]model.Network, error) {
+	fArgs, err := hdl_util.GenNetworkFilterArgs(filter)
+	if err != nil {
+		return nil, model.NewInvalidInputError(err)
+	}
 	var n []model.Network
-	nr, err := h.client.NetworkList(ctx, network.ListOptions{})
+	nr, err := h.client.NetworkList(ctx, network.ListOptions{Filters: fArgs})
 	if err != nil {
 		return nil, model.NewInternalError(err)
 	}
 	for _, r := range nr {
-		if nType, ok := hdl_util.NetTypeMap[r.Driver]; ok {
-			s, gw := hdl_util.ParseNetIPAMConfig(r.IPAM.Config)
-			n = append(n, model.Network{
-				ID:      r.ID,
-				Name:    r.Name,
-				Type:    nType,
-				Subnet:  s,
-				Gateway: gw,
-			})
+		if _, ok := hdl_util.NetTypeMap[r.Driver]; !ok && filter.SupportedOnly {
+			continue
+		}
+		if !hdl_util.MatchNetworkFilter(filter, r.ID, r.Name) {
+			continue
 		}
+		ni, err := h.client.NetworkInspect(ctx, r.ID, network.InspectOptions{})
+		if err != nil {
+			if client.IsErrNotFound(err) {
+				continue
+			}
+			return nil, model.NewInternalError(fmt.Errorf("inspecting network '%s' failed: %s", r.ID, err))
+		}
+		n = append(n, newNetwork(ni))
 	}
 	return n, nil
 }
 
 func (h *Handler) NetworkInfo(ctx context.Context, id string) (model.Network, error) {
-	n := model.Network{}
 	nr, err := h.client.NetworkInspect(ctx, id, network.InspectOptions{})
 	if err != nil {
 		if client.IsErrNotFound(err) {
@@ -56,27 +66,41 @@ func (h *Handler) NetworkInfo(ctx context.Context, id string) (model.Network, er
 		}
 		return model.Network{}, model.NewInternalError(err)
 	}
-	s, gw := hdl_util.ParseNetIPAMConfig(nr.IPAM.Config)
-	n.ID = nr.ID
-	n.Name = nr.Name
-	n.Type = hdl_util.GetConst(nr.Driver, hdl_util.NetTypeMap)
-	n.Subnet = s
-	n.Gateway = gw
-	return n, nil
+	return newNetwork(nr), nil
 }
 
 func (h *Handler) NetworkCreate(ctx context.Context, net model.Network) (string, error) {
 	if _, ok := model.NetworkTypeMap[net.Type]; !ok {
 		return "", model.NewInvalidInputError(fmt.Errorf("invalid network type '%s'", net.Type))
 	}
-	res, err := h.client.NetworkCreate(ctx, net.Name, network.CreateOptions{
+	ipamConfig, err := hdl_util.GenNetIPAMConfig(net)
+	if err != nil {
+		return "", model.NewInvalidInputError(err)
+	}
+	driverOpts, err := hdl_util.GenNetDriverOptions(net)
+	if err != nil {
+		return "", model.NewInvalidInputError(err)
+	}
+	attachable := true
+	if net.Attachable != nil {
+		attachable = *net.Attachable
+	}
+	opts := network.CreateOptions{
 		Driver:     hdl_util.NetTypeRMap[net.Type],
-		Attachable: true,
-		IPAM: &network.IPAM{
-			Config: hdl_util.GenNetIPAMConfig(net),
-		},
-	})
+		EnableIPv6: &net.EnableIPv6,
+		Internal:   net.Internal,
+		Attachable: attachable,
+		Options:    driverOpts,
+		Labels:     net.Labels,
+	}
+	if len(ipamConfig) > 0 {
+		opts.IPAM = &network.IPAM{Config: ipamConfig}
+	}
+	res, err := h.client.NetworkCreate(ctx, net.Name, opts)
 	if err != nil {
+		if errdefs.IsInvalidParameter(err) || errdefs.IsConflict(err) {
+			return "", model.NewInvalidInputError(err)
+		}
 		return "", model.NewInternalError(err)
 	}
 	if res.Warning != "" {
@@ -86,7 +110,17 @@ func (h *Handler) NetworkCreate(ctx context.Context, net model.Network) (string,
 }
 
 func (h *Handler) NetworkRemove(ctx context.Context, id string) error {
-	if err := h.client.NetworkRemove(ctx, id); err != nil {
+	nr, err := h.client.NetworkInspect(ctx, id, network.InspectOptions{})
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return model.NewNotFoundError(err)
+		}
+		return model.NewInternalError(err)
+	}
+	if _, ok := hdl_util.PredefinedNets[nr.Name]; ok {
+		return model.NewInvalidInputError(fmt.Errorf("network '%s' is predefined and can't be removed", nr.Name))
+	}
+	if err = h.client.NetworkRemove(ctx, nr.ID); err != nil {
 		if client.IsErrNotFound(err) {
 			return model.NewNotFoundError(err)
 		}
@@ -94,3 +128,58 @@ func (h *Handler) NetworkRemove(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+func newNetwork(nr network.Inspect) model.Network {
+	n := model.Network{
+		ID:         nr.ID,
+		Name:       nr.Name,
+		Type:       hdl_util.GetConst(nr.Driver, hdl_util.NetTypeMap),
+		Driver:     nr.Driver,
+		EnableIPv6: nr.EnableIPv6,
+		IPAM:       hdl_util.ParseNetIPAMConfig(nr.IPAM.Config),
+		Internal:   nr.Internal,
+		Attachable: &nr.Attachable,
+		Labels:     nr.Labels,
+		Containers: hdl_util.ParseNetContainers(nr.Containers),
+	}
+	n.Subnet, n.Gateway = hdl_util.GetNetSubnetAndGateway(n.IPAM)
+	n.Parent, n.Mode = hdl_util.ParseNetDriverOptions(n.Type, nr.Options)
+	return n
+}
+
+// checkContainerNetAddr validates static addresses of a container network. The container identified by ctrID is excluded from conflict checks.
+func (h *Handler) checkContainerNetAddr(ctx context.Context, ctrID, netID string, n model.ContainerNet) error {
+	if len(n.IPAddress) == 0 && len(n.IPv6Address) == 0 && n.MacAddress == "" {
+		return nil
+	}
+	nr, err := h.client.NetworkInspect(ctx, netID, network.InspectOptions{})
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return model.NewNotFoundError(err)
+		}
+		return model.NewInternalError(err)
+	}
+	cl, err := h.client.ContainerList(ctx, container.ListOptions{All: true, Filters: filters.NewArgs(filters.Arg("network", nr.ID))})
+	if err != nil {
+		return model.NewInternalError(err)
+	}
+	endpoints := make(map[string]*network.EndpointSettings)
+	for _, c := range cl {
+		if c.ID == ctrID || c.NetworkSettings == nil {
+			continue
+		}
+		name := c.ID
+		if len(c.Names) > 0 {
+			name = hdl_util.ParseContainerName(c.Names[0])
+		}
+		for _, es := range c.NetworkSettings.Networks {
+			if es != nil && es.NetworkID == nr.ID {
+				endpoints[name] = es
+			}
+		}
+	}
+	if err = hdl_util.CheckContainerNetAddr(n, nr, endpoints); err != nil {
+		return model.NewInvalidInputError(err)
+	}
+	return nil
+}
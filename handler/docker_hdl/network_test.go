@@ -0,0 +1,116 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package docker_hdl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+)
+
+// testEngine serves network inspect and remove requests for a set of networks identified by name or ID.
+type testEngine struct {
+	networks map[string]string
+	removed  []string
+}
+
+func (e *testEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := path.Base(r.URL.Path)
+	driver, ok := e.networks[name]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"network not found"}`))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(network.Inspect{ID: name + "-id", Name: name, Driver: driver})
+	case http.MethodDelete:
+		e.removed = append(e.removed, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestHandler_NetworkRemove(t *testing.T) {
+	isInvalidInput := func(err error) bool {
+		var e *model.InvalidInputError
+		return errors.As(err, &e)
+	}
+	isNotFound := func(err error) bool {
+		var e *model.NotFoundError
+		return errors.As(err, &e)
+	}
+	tests := []struct {
+		name        string
+		wantRemoved bool
+		wantErr     func(error) bool
+	}{
+		{name: "bridge", wantErr: isInvalidInput},
+		{name: "host", wantErr: isInvalidInput},
+		{name: "none", wantErr: isInvalidInput},
+		{name: "ingress", wantErr: isInvalidInput},
+		{name: "docker_gwbridge", wantErr: isInvalidInput},
+		{name: "unknown", wantErr: isNotFound},
+		{name: "custom", wantRemoved: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &testEngine{networks: map[string]string{
+				"bridge":          "bridge",
+				"host":            "host",
+				"none":            "null",
+				"ingress":         "overlay",
+				"docker_gwbridge": "bridge",
+				"custom-id":       "bridge",
+				"custom":          "bridge",
+			}}
+			srv := httptest.NewServer(e)
+			defer srv.Close()
+			c, err := client.NewClientWithOpts(client.WithHost("tcp://"+srv.Listener.Addr().String()), client.WithVersion("1.47"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Close()
+			h := &Handler{client: c}
+			err = h.NetworkRemove(context.Background(), tc.name)
+			if tc.wantErr != nil {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if !tc.wantErr(err) {
+					t.Errorf("unexpected error type %T: %s", err, err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if removed := len(e.removed) > 0; removed != tc.wantRemoved {
+				t.Errorf("expected removed %v, got %v", tc.wantRemoved, removed)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package docker_hdl
+
+import (
+	"context"
+	"errors"
+	hdl_util "github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/docker_hdl/util"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/docker/docker/api/types"
+)
+
+func (h *Handler) PruneContainers(ctx context.Context, filter model.PruneFilter) (model.PruneReport, error) {
+	if filter.All {
+		return model.PruneReport{}, model.NewInvalidInputError(errors.New("option 'all' not supported for containers"))
+	}
+	fArgs, err := hdl_util.GenPruneFilterArgs(filter)
+	if err != nil {
+		return model.PruneReport{}, model.NewInvalidInputError(err)
+	}
+	res, err := h.client.ContainersPrune(ctx, fArgs)
+	if err != nil {
+		return model.PruneReport{}, model.NewInternalError(err)
+	}
+	return model.PruneReport{
+		Removed:        res.ContainersDeleted,
+		SpaceReclaimed: res.SpaceReclaimed,
+	}, nil
+}
+
+func (h *Handler) PruneImages(ctx context.Context, filter model.PruneFilter) (model.PruneReport, error) {
+	fArgs, err := hdl_util.GenPruneFilterArgs(filter)
+	if err != nil {
+		return model.PruneReport{}, model.NewInvalidInputError(err)
+	}
+	if filter.All {
+		fArgs.Add("dangling", "false")
+	}
+	res, err := h.client.ImagesPrune(ctx, fArgs)
+	if err != nil {
+		return model.PruneReport{}, model.NewInternalError(err)
+	}
+	report := model.PruneReport{SpaceReclaimed: res.SpaceReclaimed}
+	for _, item := range res.ImagesDeleted {
+		if item.Deleted != "" {
+			report.Removed = append(report.Removed, item.Deleted)
+		}
+	}
+	return report, nil
+}
+
+func (h *Handler) PruneNetworks(ctx context.Context, filter model.PruneFilter) (model.PruneReport, error) {
+	if filter.All {
+		return model.PruneReport{}, model.NewInvalidInputError(errors.New("option 'all' not supported for networks"))
+	}
+	fArgs, err := hdl_util.GenPruneFilterArgs(filter)
+	if err != nil {
+		return model.PruneReport{}, model.NewInvalidInputError(err)
+	}
+	res, err := h.client.NetworksPrune(ctx, fArgs)
+	if err != nil {
+		return model.PruneReport{}, model.NewInternalError(err)
+	}
+	return model.PruneReport{Removed: res.NetworksDeleted}, nil
+}
+
+func (h *Handler) PruneVolumes(ctx context.Context, filter model.PruneFilter) (model.PruneReport, error) {
+	if filter.OlderThan != 0 {
+		return model.PruneReport{}, model.NewInvalidInputError(errors.New("option 'older than' not supported for volumes"))
+	}
+	fArgs, err := hdl_util.GenPruneFilterArgs(filter)
+	if err != nil {
+		return model.PruneReport{}, model.NewInvalidInputError(err)
+	}
+	if filter.All {
+		fArgs.Add("all", "true")
+	}
+	res, err := h.client.VolumesPrune(ctx, fArgs)
+	if err != nil {
+		return model.PruneReport{}, model.NewInternalError(err)
+	}
+	return model.PruneReport{
+		Removed:        res.VolumesDeleted,
+		SpaceReclaimed: res.SpaceReclaimed,
+	}, nil
+}
+
+func (h *Handler) PruneBuildCache(ctx context.Context, filter model.PruneFilter) (model.PruneReport, error) {
+	if len(filter.Labels) > 0 {
+		return model.PruneReport{}, model.NewInvalidInputError(errors.New("option 'labels' not supported for build cache"))
+	}
+	fArgs, err := hdl_util.GenPruneFilterArgs(filter)
+	if err != nil {
+		return model.PruneReport{}, model.NewInvalidInputError(err)
+	}
+	res, err := h.client.BuildCachePrune(ctx, types.BuildCachePruneOptions{All: filter.All, Filters: fArgs})
+	if err != nil {
+		return model.PruneReport{}, model.NewInternalError(err)
+	}
+	return model.PruneReport{
+		Removed:        res.CachesDeleted,
+		SpaceReclaimed: res.SpaceReclaimed,
+	}, nil
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import "bytes"
+
+// LimitedBuffer stores up to max bytes and silently discards the rest.
+type LimitedBuffer struct {
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func NewLimitedBuffer(max int) *LimitedBuffer {
+	return &LimitedBuffer{max: max}
+}
+
+func (b *LimitedBuffer) Write(p []byte) (int, error) {
+	r := b.max - b.buf.Len()
+	if len(p) > r {
+		b.truncated = true
+		if r > 0 {
+			b.buf.Write(p[:r])
+		}
+	} else {
+		b.buf.Write(p)
+	}
+	return len(p), nil
+}
+
+func (b *LimitedBuffer) String() string {
+	return b.buf.String()
+}
+
+func (b *LimitedBuffer) Truncated() bool {
+	return b.truncated
+}
@@ -19,6 +19,7 @@ package util
 import (
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/api/types/mount"
 )
 
@@ -92,9 +93,29 @@ var PortTypeRMap = func() map[model.PortType]string {
 var NetTypeMap = map[string]model.NetworkType{
 	"bridge":  model.BridgeNet,
 	"macvlan": model.MACVlanNet,
+	"ipvlan":  model.IPVlanNet,
 	"host":    model.HostNet,
 }
 
+const (
+	netParentOpt      = "parent"
+	netMACVlanModeOpt = "macvlan_mode"
+	netIPVlanModeOpt  = "ipvlan_mode"
+)
+
+const (
+	volMountOpt   = "o"
+	redactedValue = "<redacted>"
+)
+
+var volSensitiveMountOpts = map[string]struct{}{
+	"username":    {},
+	"user":        {},
+	"password":    {},
+	"pass":        {},
+	"credentials": {},
+}
+
 var NetTypeRMap = func() map[model.NetworkType]string {
 	m := make(map[model.NetworkType]string)
 	for k, v := range NetTypeMap {
@@ -103,6 +124,40 @@ var NetTypeRMap = func() map[model.NetworkType]string {
 	return m
 }()
 
+// PredefinedNets contains the names of networks created by the engine, which can't be removed.
+var PredefinedNets = map[string]struct{}{
+	"bridge":          {},
+	"host":            {},
+	"none":            {},
+	"ingress":         {},
+	"docker_gwbridge": {},
+}
+
+var EventTypeMap = map[events.Type]model.EventType{
+	events.ContainerEventType: model.ContainerEvent,
+	events.ImageEventType:     model.ImageEvent,
+	events.VolumeEventType:    model.VolumeEvent,
+	events.NetworkEventType:   model.NetworkEvent,
+}
+
+var EventTypeRMap = func() map[model.EventType]events.Type {
+	m := make(map[model.EventType]events.Type)
+	for k, v := range EventTypeMap {
+		m[v] = k
+	}
+	return m
+}()
+
+var EventStateMap = map[events.Action]model.ContainerState{
+	events.ActionCreate:  model.InitState,
+	events.ActionStart:   model.RunningState,
+	events.ActionRestart: model.RunningState,
+	events.ActionUnPause: model.RunningState,
+	events.ActionPause:   model.PausedState,
+	events.ActionStop:    model.StoppedState,
+	events.ActionDie:     model.StoppedState,
+}
+
 func GetConst(s string, m map[string]string) string {
 	if c, ok := m[s]; ok {
 		return c
@@ -17,12 +17,16 @@
 package util
 
 import (
+	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/distribution/reference"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/mount"
@@ -169,6 +173,37 @@ func GenContainerFilterArgs(filter model.ContainerFilter) filters.Args {
 	return fArgs
 }
 
+func GenNetworkFilterArgs(filter model.NetworkFilter) (filters.Args, error) {
+	fArgs := filters.NewArgs()
+	for _, id := range filter.Ids {
+		fArgs.Add("id", id)
+	}
+	for _, name := range filter.Names {
+		fArgs.Add("name", name)
+	}
+	if filter.Type != "" {
+		driver, ok := NetTypeRMap[filter.Type]
+		if !ok {
+			return filters.Args{}, fmt.Errorf("invalid network type '%s'", filter.Type)
+		}
+		fArgs.Add("driver", driver)
+	}
+	genLabelFilterArgs(&fArgs, filter.Labels)
+	return fArgs, nil
+}
+
+// MatchNetworkFilter checks if a network matches one of the filter names exactly and one of the filter IDs completely or by prefix.
+// The engine also matches partial names and IDs, hence results must be checked.
+func MatchNetworkFilter(filter model.NetworkFilter, id, name string) bool {
+	if len(filter.Names) > 0 && !slices.Contains(filter.Names, name) {
+		return false
+	}
+	if len(filter.Ids) > 0 && !slices.ContainsFunc(filter.Ids, func(s string) bool { return s != "" && strings.HasPrefix(id, s) }) {
+		return false
+	}
+	return true
+}
+
 func GenImageFilterArgs(filter model.ImageFilter) filters.Args {
 	fArgs := filters.NewArgs()
 	genLabelFilterArgs(&fArgs, filter.Labels)
@@ -186,12 +221,89 @@ func GenVolumeFilterArgs(filter model.VolumeFilter) filters.Args {
 	return fArgs
 }
 
-func GenNetIPAMConfig(n model.Network) (c []network.IPAMConfig) {
-	c = append(c, network.IPAMConfig{
-		Subnet:  n.Subnet.KeyStr(),
-		Gateway: net.IP(n.Gateway).String(),
-	})
-	return
+func GenEventFilterArgs(filter model.EventFilter) filters.Args {
+	fArgs := filters.NewArgs()
+	if len(filter.Types) > 0 {
+		for _, t := range filter.Types {
+			fArgs.Add("type", string(EventTypeRMap[t]))
+		}
+	} else {
+		for t := range EventTypeMap {
+			fArgs.Add("type", string(t))
+		}
+	}
+	genLabelFilterArgs(&fArgs, filter.Labels)
+	return fArgs
+}
+
+func GenNetIPAMConfig(n model.Network) ([]network.IPAMConfig, error) {
+	configs := n.IPAM
+	if len(configs) == 0 && (!n.Subnet.IsZero() || len(n.Gateway) > 0) {
+		configs = []model.IPAMConfig{{Subnet: n.Subnet, Gateway: n.Gateway}}
+	}
+	var c []network.IPAMConfig
+	for _, config := range configs {
+		if config.Subnet.IsZero() {
+			if !config.IPRange.IsZero() || len(config.Gateway) > 0 || len(config.AuxAddresses) > 0 {
+				return nil, errors.New("ip range, gateway and auxiliary addresses require a subnet")
+			}
+			continue
+		}
+		subnet, err := genIPNet(config.Subnet)
+		if err != nil {
+			return nil, err
+		}
+		if subnet.IP.To4() == nil && !n.EnableIPv6 {
+			return nil, fmt.Errorf("ipv6 subnet '%s' requires ipv6 to be enabled", subnet)
+		}
+		ipamConfig := network.IPAMConfig{Subnet: subnet.String()}
+		if !config.IPRange.IsZero() {
+			ipRange, err := genIPNet(config.IPRange)
+			if err != nil {
+				return nil, err
+			}
+			if rBits, _ := ipRange.Mask.Size(); !subnet.Contains(ipRange.IP) || rBits < config.Subnet.Bits {
+				return nil, fmt.Errorf("ip range '%s' not within subnet '%s'", ipRange, subnet)
+			}
+			ipamConfig.IPRange = ipRange.String()
+		}
+		if len(config.Gateway) > 0 {
+			if !subnet.Contains(net.IP(config.Gateway)) {
+				return nil, fmt.Errorf("gateway '%s' not within subnet '%s'", net.IP(config.Gateway), subnet)
+			}
+			ipamConfig.Gateway = net.IP(config.Gateway).String()
+		}
+		for name, addr := range config.AuxAddresses {
+			if !subnet.Contains(net.IP(addr)) {
+				return nil, fmt.Errorf("auxiliary address '%s' not within subnet '%s'", net.IP(addr), subnet)
+			}
+			if ipamConfig.AuxAddress == nil {
+				ipamConfig.AuxAddress = make(map[string]string)
+			}
+			ipamConfig.AuxAddress[name] = net.IP(addr).String()
+		}
+		c = append(c, ipamConfig)
+	}
+	return c, nil
+}
+
+func genIPNet(s model.Subnet) (*net.IPNet, error) {
+	ip := net.IP(s.Prefix)
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+		bits = 8 * net.IPv4len
+	} else if len(ip) != net.IPv6len {
+		return nil, fmt.Errorf("invalid subnet prefix '%s'", ip)
+	}
+	if s.Bits < 0 || s.Bits > bits {
+		return nil, fmt.Errorf("invalid subnet bits '%d'", s.Bits)
+	}
+	ipNet := &net.IPNet{IP: ip, Mask: net.CIDRMask(s.Bits, bits)}
+	if !ip.Equal(ip.Mask(ipNet.Mask)) {
+		return nil, fmt.Errorf("subnet prefix '%s' has host bits set", ip)
+	}
+	return ipNet, nil
 }
 
 func GenRestartPolicy(strategy model.RestartStrategy, retries *int) (rp container.RestartPolicy, err error) {
@@ -210,6 +322,157 @@ func GenRestartPolicy(strategy model.RestartStrategy, retries *int) (rp containe
 	return
 }
 
+func GenResources(r model.Resources) (res container.Resources, err error) {
+	if r.Memory < 0 {
+		err = fmt.Errorf("invalid memory limit '%d'", r.Memory)
+		return
+	}
+	if r.MemoryReservation < 0 {
+		err = fmt.Errorf("invalid memory reservation '%d'", r.MemoryReservation)
+		return
+	}
+	if r.MemorySwap < -1 || (r.MemorySwap > 0 && r.MemorySwap < r.Memory) {
+		err = fmt.Errorf("invalid memory swap limit '%d'", r.MemorySwap)
+		return
+	}
+	if r.CPUs < 0 {
+		err = fmt.Errorf("invalid number of cpus '%v'", r.CPUs)
+		return
+	}
+	if r.CPUShares < 0 {
+		err = fmt.Errorf("invalid cpu shares '%d'", r.CPUShares)
+		return
+	}
+	if r.PidsLimit < -1 {
+		err = fmt.Errorf("invalid pids limit '%d'", r.PidsLimit)
+		return
+	}
+	res.Memory = r.Memory
+	res.MemoryReservation = r.MemoryReservation
+	res.MemorySwap = r.MemorySwap
+	res.NanoCPUs = int64(r.CPUs * 1e9)
+	res.CPUShares = r.CPUShares
+	res.CpusetCpus = r.CPUSet
+	if r.PidsLimit != 0 {
+		res.PidsLimit = &r.PidsLimit
+	}
+	res.Ulimits, err = GenUlimits(r.Ulimits)
+	return
+}
+
+func GenUlimits(ulimits []model.Ulimit) ([]*container.Ulimit, error) {
+	var uls []*container.Ulimit
+	set := make(map[string]struct{})
+	for _, u := range ulimits {
+		if u.Name == "" {
+			return nil, fmt.Errorf("invalid ulimit name '%s'", u.Name)
+		}
+		if _, ok := set[u.Name]; ok {
+			return nil, fmt.Errorf("ulimit duplicate '%s'", u.Name)
+		}
+		if u.Soft > u.Hard {
+			return nil, fmt.Errorf("invalid ulimit '%s': soft limit exceeds hard limit", u.Name)
+		}
+		set[u.Name] = struct{}{}
+		uls = append(uls, &container.Ulimit{
+			Name: u.Name,
+			Soft: u.Soft,
+			Hard: u.Hard,
+		})
+	}
+	return uls, nil
+}
+
+func GenEndpointSettings(n model.ContainerNet) (*network.EndpointSettings, error) {
+	es := &network.EndpointSettings{
+		Aliases: n.DomainNames,
+	}
+	if n.MacAddress != "" {
+		mac, err := net.ParseMAC(n.MacAddress)
+		if err != nil {
+			return nil, err
+		}
+		es.MacAddress = mac.String()
+	}
+	if len(n.IPAddress) > 0 || len(n.IPv6Address) > 0 {
+		es.IPAMConfig = &network.EndpointIPAMConfig{}
+		if len(n.IPAddress) > 0 {
+			ip := net.IP(n.IPAddress).To4()
+			if ip == nil {
+				return nil, fmt.Errorf("invalid ipv4 address '%s'", net.IP(n.IPAddress))
+			}
+			es.IPAMConfig.IPv4Address = ip.String()
+		}
+		if len(n.IPv6Address) > 0 {
+			ip := net.IP(n.IPv6Address)
+			if ip.To4() != nil || ip.To16() == nil {
+				return nil, fmt.Errorf("invalid ipv6 address '%s'", ip)
+			}
+			es.IPAMConfig.IPv6Address = ip.String()
+		}
+	}
+	return es, nil
+}
+
+// CheckContainerNetAddr validates static addresses against the subnets of a network and the endpoints of other containers.
+func CheckContainerNetAddr(n model.ContainerNet, nr network.Inspect, endpoints map[string]*network.EndpointSettings) error {
+	var subnets []*net.IPNet
+	reserved := make(map[string]struct{})
+	for _, c := range nr.IPAM.Config {
+		if _, ipNet, err := net.ParseCIDR(c.Subnet); err == nil {
+			subnets = append(subnets, ipNet)
+		}
+		if c.Gateway != "" {
+			reserved[net.ParseIP(c.Gateway).String()] = struct{}{}
+		}
+		for _, addr := range c.AuxAddress {
+			reserved[net.ParseIP(addr).String()] = struct{}{}
+		}
+	}
+	for _, addr := range []model.IPAddr{n.IPAddress, n.IPv6Address} {
+		if len(addr) == 0 {
+			continue
+		}
+		ip := net.IP(addr)
+		if !inSubnets(subnets, ip) {
+			return fmt.Errorf("address '%s' not within subnets of network '%s'", ip, nr.Name)
+		}
+		if _, ok := reserved[ip.String()]; ok {
+			return fmt.Errorf("address '%s' reserved by network '%s'", ip, nr.Name)
+		}
+	}
+	mac, _ := net.ParseMAC(n.MacAddress)
+	for name, es := range endpoints {
+		if es == nil {
+			continue
+		}
+		addrs := []string{es.IPAddress, es.GlobalIPv6Address}
+		if es.IPAMConfig != nil {
+			addrs = append(addrs, es.IPAMConfig.IPv4Address, es.IPAMConfig.IPv6Address)
+		}
+		for _, addr := range addrs {
+			if ip := net.ParseIP(addr); ip != nil && (ip.Equal(net.IP(n.IPAddress)) || ip.Equal(net.IP(n.IPv6Address))) {
+				return fmt.Errorf("address '%s' already in use by container '%s'", ip, name)
+			}
+		}
+		if mac != nil {
+			if m, err := net.ParseMAC(es.MacAddress); err == nil && m.String() == mac.String() {
+				return fmt.Errorf("mac address '%s' already in use by container '%s'", mac, name)
+			}
+		}
+	}
+	return nil
+}
+
+func inSubnets(subnets []*net.IPNet, ip net.IP) bool {
+	for _, subnet := range subnets {
+		if subnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 func CheckNetworks(n []model.ContainerNet) error {
 	set := make(map[string]struct{})
 	for _, net := range n {
@@ -220,3 +483,61 @@ func CheckNetworks(n []model.ContainerNet) error {
 	}
 	return nil
 }
+
+// GenImageRef combines repository and tag to a familiar image reference (e.g. nginx:latest). The tag defaults to 'latest'.
+func GenImageRef(repo, tag string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(repo)
+	if err != nil {
+		return "", err
+	}
+	if !reference.IsNameOnly(named) {
+		return "", fmt.Errorf("repository '%s' must not contain a tag or digest", repo)
+	}
+	if tag == "" {
+		tag = "latest"
+	}
+	tagged, err := reference.WithTag(named, tag)
+	if err != nil {
+		return "", err
+	}
+	return reference.FamiliarString(tagged), nil
+}
+
+func GenPruneFilterArgs(filter model.PruneFilter) (filters.Args, error) {
+	fArgs := filters.NewArgs()
+	genLabelFilterArgs(&fArgs, filter.Labels)
+	if filter.OlderThan < 0 {
+		return fArgs, fmt.Errorf("invalid duration '%s'", filter.OlderThan)
+	}
+	if filter.OlderThan > 0 {
+		fArgs.Add("until", filter.OlderThan.String())
+	}
+	return fArgs, nil
+}
+
+func GenNetDriverOptions(n model.Network) (map[string]string, error) {
+	var modeOpt string
+	var modeMap map[model.NetworkMode]struct{}
+	switch n.Type {
+	case model.MACVlanNet:
+		modeOpt, modeMap = netMACVlanModeOpt, model.MACVlanModeMap
+	case model.IPVlanNet:
+		modeOpt, modeMap = netIPVlanModeOpt, model.IPVlanModeMap
+	default:
+		if n.Parent != "" || n.Mode != "" {
+			return nil, fmt.Errorf("parent and mode not supported for network type '%s'", n.Type)
+		}
+		return nil, nil
+	}
+	opts := make(map[string]string)
+	if n.Parent != "" {
+		opts[netParentOpt] = n.Parent
+	}
+	if n.Mode != "" {
+		if _, ok := modeMap[n.Mode]; !ok {
+			return nil, fmt.Errorf("invalid mode '%s' for network type '%s'", n.Mode, n.Type)
+		}
+		opts[modeOpt] = n.Mode
+	}
+	return opts, nil
+}
@@ -0,0 +1,319 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/docker/docker/api/types/network"
+)
+
+func testIPAddr(s string) model.IPAddr {
+	return model.IPAddr(net.ParseIP(s))
+}
+
+func TestGenNetIPAMConfig(t *testing.T) {
+	subnet4 := model.Subnet{Prefix: testIPAddr("10.0.0.0"), Bits: 24}
+	subnet6 := model.Subnet{Prefix: testIPAddr("fd00::"), Bits: 64}
+	tests := []struct {
+		name    string
+		input   model.Network
+		want    []network.IPAMConfig
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "legacy subnet and gateway",
+			input: model.Network{Subnet: subnet4, Gateway: testIPAddr("10.0.0.1")},
+			want:  []network.IPAMConfig{{Subnet: "10.0.0.0/24", Gateway: "10.0.0.1"}},
+		},
+		{
+			name: "ipam configs take precedence",
+			input: model.Network{
+				Subnet: model.Subnet{Prefix: testIPAddr("10.1.0.0"), Bits: 16},
+				IPAM:   []model.IPAMConfig{{Subnet: subnet4}},
+			},
+			want: []network.IPAMConfig{{Subnet: "10.0.0.0/24"}},
+		},
+		{
+			name: "ip range and aux addresses",
+			input: model.Network{IPAM: []model.IPAMConfig{{
+				Subnet:       subnet4,
+				IPRange:      model.Subnet{Prefix: testIPAddr("10.0.0.128"), Bits: 25},
+				Gateway:      testIPAddr("10.0.0.1"),
+				AuxAddresses: map[string]model.IPAddr{"host": testIPAddr("10.0.0.2")},
+			}}},
+			want: []network.IPAMConfig{{
+				Subnet:     "10.0.0.0/24",
+				IPRange:    "10.0.0.128/25",
+				Gateway:    "10.0.0.1",
+				AuxAddress: map[string]string{"host": "10.0.0.2"},
+			}},
+		},
+		{
+			name: "dual stack",
+			input: model.Network{
+				EnableIPv6: true,
+				IPAM:       []model.IPAMConfig{{Subnet: subnet4}, {Subnet: subnet6, Gateway: testIPAddr("fd00::1")}},
+			},
+			want: []network.IPAMConfig{{Subnet: "10.0.0.0/24"}, {Subnet: "fd00::/64", Gateway: "fd00::1"}},
+		},
+		{
+			name:  "empty config skipped",
+			input: model.Network{IPAM: []model.IPAMConfig{{}, {Subnet: subnet4}}},
+			want:  []network.IPAMConfig{{Subnet: "10.0.0.0/24"}},
+		},
+		{
+			name:    "ipv6 not enabled",
+			input:   model.Network{IPAM: []model.IPAMConfig{{Subnet: subnet6}}},
+			wantErr: true,
+		},
+		{
+			name:    "gateway without subnet",
+			input:   model.Network{IPAM: []model.IPAMConfig{{Gateway: testIPAddr("10.0.0.1")}}},
+			wantErr: true,
+		},
+		{
+			name:    "host bits set",
+			input:   model.Network{IPAM: []model.IPAMConfig{{Subnet: model.Subnet{Prefix: testIPAddr("10.0.0.1"), Bits: 24}}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid bits",
+			input:   model.Network{IPAM: []model.IPAMConfig{{Subnet: model.Subnet{Prefix: testIPAddr("10.0.0.0"), Bits: 33}}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid prefix",
+			input:   model.Network{IPAM: []model.IPAMConfig{{Subnet: model.Subnet{Prefix: model.IPAddr{10, 0}, Bits: 8}}}},
+			wantErr: true,
+		},
+		{
+			name:    "ip range outside subnet",
+			input:   model.Network{IPAM: []model.IPAMConfig{{Subnet: subnet4, IPRange: model.Subnet{Prefix: testIPAddr("10.0.1.0"), Bits: 25}}}},
+			wantErr: true,
+		},
+		{
+			name:    "ip range larger than subnet",
+			input:   model.Network{IPAM: []model.IPAMConfig{{Subnet: subnet4, IPRange: model.Subnet{Prefix: testIPAddr("10.0.0.0"), Bits: 16}}}},
+			wantErr: true,
+		},
+		{
+			name:    "gateway outside subnet",
+			input:   model.Network{IPAM: []model.IPAMConfig{{Subnet: subnet4, Gateway: testIPAddr("10.0.1.1")}}},
+			wantErr: true,
+		},
+		{
+			name:    "aux address outside subnet",
+			input:   model.Network{IPAM: []model.IPAMConfig{{Subnet: subnet4, AuxAddresses: map[string]model.IPAddr{"host": testIPAddr("10.0.1.2")}}}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := GenNetIPAMConfig(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCheckContainerNetAddr(t *testing.T) {
+	nr := network.Inspect{
+		Name: "test",
+		IPAM: network.IPAM{Config: []network.IPAMConfig{
+			{Subnet: "10.0.0.0/24", Gateway: "10.0.0.1", AuxAddress: map[string]string{"host": "10.0.0.2"}},
+			{Subnet: "fd00::/64", Gateway: "fd00::1"},
+		}},
+	}
+	endpoints := map[string]*network.EndpointSettings{
+		"ctr1": {IPAddress: "10.0.0.10", MacAddress: "02:42:0a:00:00:0a"},
+		"ctr2": {IPAMConfig: &network.EndpointIPAMConfig{IPv4Address: "10.0.0.11", IPv6Address: "fd00::11"}},
+		"ctr3": {GlobalIPv6Address: "fd00::12"},
+		"ctr4": nil,
+	}
+	tests := []struct {
+		name    string
+		input   model.ContainerNet
+		wantErr bool
+	}{
+		{
+			name: "no addresses",
+		},
+		{
+			name:  "free ipv4 address",
+			input: model.ContainerNet{IPAddress: testIPAddr("10.0.0.20")},
+		},
+		{
+			name:  "free ipv6 address",
+			input: model.ContainerNet{IPv6Address: testIPAddr("fd00::20")},
+		},
+		{
+			name:  "free mac address",
+			input: model.ContainerNet{MacAddress: "02:42:0a:00:00:14"},
+		},
+		{
+			name:    "ipv4 address outside subnets",
+			input:   model.ContainerNet{IPAddress: testIPAddr("10.0.1.20")},
+			wantErr: true,
+		},
+		{
+			name:    "ipv6 address outside subnets",
+			input:   model.ContainerNet{IPv6Address: testIPAddr("fd01::20")},
+			wantErr: true,
+		},
+		{
+			name:    "gateway",
+			input:   model.ContainerNet{IPAddress: testIPAddr("10.0.0.1")},
+			wantErr: true,
+		},
+		{
+			name:    "ipv6 gateway",
+			input:   model.ContainerNet{IPv6Address: testIPAddr("fd00::1")},
+			wantErr: true,
+		},
+		{
+			name:    "aux address",
+			input:   model.ContainerNet{IPAddress: testIPAddr("10.0.0.2")},
+			wantErr: true,
+		},
+		{
+			name:    "used by endpoint",
+			input:   model.ContainerNet{IPAddress: testIPAddr("10.0.0.10")},
+			wantErr: true,
+		},
+		{
+			name:    "configured for endpoint",
+			input:   model.ContainerNet{IPAddress: testIPAddr("10.0.0.11")},
+			wantErr: true,
+		},
+		{
+			name:    "ipv6 configured for endpoint",
+			input:   model.ContainerNet{IPv6Address: testIPAddr("fd00::11")},
+			wantErr: true,
+		},
+		{
+			name:    "ipv6 used by endpoint",
+			input:   model.ContainerNet{IPv6Address: testIPAddr("fd00::12")},
+			wantErr: true,
+		},
+		{
+			name:    "mac address used by endpoint",
+			input:   model.ContainerNet{MacAddress: "02:42:0A:00:00:0A"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckContainerNetAddr(tc.input, nr, endpoints)
+			if tc.wantErr && err == nil {
+				t.Error("expected error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMatchNetworkFilter(t *testing.T) {
+	id := "4f1b3c2a9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a"
+	tests := []struct {
+		name   string
+		filter model.NetworkFilter
+		want   bool
+	}{
+		{name: "no filter", want: true},
+		{name: "exact name", filter: model.NetworkFilter{Names: []string{"other", "test"}}, want: true},
+		{name: "partial name", filter: model.NetworkFilter{Names: []string{"tes"}}},
+		{name: "longer name", filter: model.NetworkFilter{Names: []string{"test-net"}}},
+		{name: "full id", filter: model.NetworkFilter{Ids: []string{id}}, want: true},
+		{name: "short id", filter: model.NetworkFilter{Ids: []string{"4f1b3c2a9d8e"}}, want: true},
+		{name: "id substring", filter: model.NetworkFilter{Ids: []string{"3c2a9d8e"}}},
+		{name: "empty id", filter: model.NetworkFilter{Ids: []string{""}}},
+		{name: "name and id", filter: model.NetworkFilter{Names: []string{"test"}, Ids: []string{"4f1b"}}, want: true},
+		{name: "name and other id", filter: model.NetworkFilter{Names: []string{"test"}, Ids: []string{"5f1b"}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := MatchNetworkFilter(tc.filter, id, "test"); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGenNetworkFilterArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  model.NetworkFilter
+		want    map[string][]string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			want: map[string][]string{},
+		},
+		{
+			name:   "all fields",
+			filter: model.NetworkFilter{Ids: []string{"abc"}, Names: []string{"test"}, Type: model.BridgeNet, Labels: map[string]string{"a": "b"}},
+			want:   map[string][]string{"id": {"abc"}, "name": {"test"}, "driver": {"bridge"}, "label": {"a=b"}},
+		},
+		{
+			name:    "unknown type",
+			filter:  model.NetworkFilter{Type: "unknown"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := GenNetworkFilterArgs(tc.filter)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Len() != len(tc.want) {
+				t.Errorf("expected %d filters, got %d", len(tc.want), got.Len())
+			}
+			for key, vals := range tc.want {
+				if gotVals := got.Get(key); !reflect.DeepEqual(gotVals, vals) {
+					t.Errorf("expected %v for '%s', got %v", vals, key, gotVals)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"fmt"
+	"github.com/distribution/reference"
+)
+
+// ImageRefFilter matches image references by normalized repository name and optional tag or digest.
+type ImageRefFilter struct {
+	name   string
+	tag    string
+	digest string
+}
+
+// NewImageRefFilter parses a name (e.g. nginx, docker.io/library/nginx:latest or nginx@sha256:...) and an optional tag.
+func NewImageRefFilter(name, tag string) (ImageRefFilter, error) {
+	named, err := reference.ParseNormalizedNamed(name)
+	if err != nil {
+		return ImageRefFilter{}, err
+	}
+	f := ImageRefFilter{
+		name: named.Name(),
+		tag:  tag,
+	}
+	if tagged, ok := named.(reference.Tagged); ok {
+		if tag != "" && tag != tagged.Tag() {
+			return ImageRefFilter{}, fmt.Errorf("tag '%s' does not match tag of '%s'", tag, name)
+		}
+		f.tag = tagged.Tag()
+	}
+	if f.tag != "" {
+		if _, err = reference.WithTag(named, f.tag); err != nil {
+			return ImageRefFilter{}, err
+		}
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		f.digest = digested.Digest().String()
+	}
+	return f, nil
+}
+
+func (f ImageRefFilter) Match(repoTags, repoDigests []string) bool {
+	if f.digest != "" {
+		for _, s := range repoDigests {
+			if ref, err := reference.ParseNormalizedNamed(s); err == nil && ref.Name() == f.name {
+				if digested, ok := ref.(reference.Digested); ok && digested.Digest().String() == f.digest {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for _, s := range repoTags {
+		if ref, err := reference.ParseNormalizedNamed(s); err == nil && ref.Name() == f.name {
+			if f.tag == "" {
+				return true
+			}
+			if tagged, ok := ref.(reference.Tagged); ok && tagged.Tag() == f.tag {
+				return true
+			}
+		}
+	}
+	return false
+}
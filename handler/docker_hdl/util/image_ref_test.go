@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import "testing"
+
+func TestNewImageRefFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		wantErr bool
+	}{
+		{name: "nginx"},
+		{name: "nginx", tag: "1.25"},
+		{name: "nginx:1.25"},
+		{name: "nginx:1.25", tag: "1.25"},
+		{name: "docker.io/library/nginx:latest"},
+		{name: "nginx@sha256:0000000000000000000000000000000000000000000000000000000000000000"},
+		{name: "nginx:1.25", tag: "1.26", wantErr: true},
+		{name: "nginx", tag: "invalid tag", wantErr: true},
+		{name: "Nginx", wantErr: true},
+		{name: "", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name+":"+tc.tag, func(t *testing.T) {
+			_, err := NewImageRefFilter(tc.name, tc.tag)
+			if tc.wantErr && err == nil {
+				t.Error("expected error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestImageRefFilter_Match(t *testing.T) {
+	digest := "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	otherDigest := "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	repoTags := []string{"nginx:1.25", "nginx:latest", "registry.example.com/team/app:v1"}
+	repoDigests := []string{"nginx@" + digest, "registry.example.com/team/app@" + otherDigest}
+	tests := []struct {
+		name string
+		tag  string
+		want bool
+	}{
+		{name: "nginx", want: true},
+		{name: "docker.io/library/nginx", want: true},
+		{name: "library/nginx", want: true},
+		{name: "nginx", tag: "1.25", want: true},
+		{name: "nginx:latest", want: true},
+		{name: "docker.io/library/nginx:1.25", want: true},
+		{name: "nginx", tag: "1.26"},
+		{name: "nginx@" + digest, want: true},
+		{name: "docker.io/library/nginx@" + digest, want: true},
+		{name: "nginx@" + otherDigest},
+		{name: "registry.example.com/team/app", want: true},
+		{name: "registry.example.com/team/app", tag: "v1", want: true},
+		{name: "registry.example.com/team/app@" + otherDigest, want: true},
+		{name: "team/app"},
+		{name: "ngin"},
+		{name: "nginx-proxy"},
+		{name: "other/nginx"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name+":"+tc.tag, func(t *testing.T) {
+			f, err := NewImageRefFilter(tc.name, tc.tag)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := f.Match(repoTags, repoDigests); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"strings"
+)
+
+const (
+	loadedImagePrefix   = "Loaded image: "
+	loadedImageIDPrefix = "Loaded image ID: "
+)
+
+type ImgLoadResp struct {
+	Stream string `json:"stream"`
+	Error  string `json:"error"`
+}
+
+func (r ImgLoadResp) String() string {
+	return strings.TrimSpace(r.Stream)
+}
+
+// Image returns the reference or ID of a loaded image or an empty string if the message does not report a loaded image.
+func (r ImgLoadResp) Image() string {
+	s := strings.TrimSpace(r.Stream)
+	if img, ok := strings.CutPrefix(s, loadedImageIDPrefix); ok {
+		return img
+	}
+	if img, ok := strings.CutPrefix(s, loadedImagePrefix); ok {
+		return img
+	}
+	return ""
+}
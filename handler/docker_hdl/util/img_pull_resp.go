@@ -19,6 +19,10 @@ package util
 import (
 	"bytes"
 	"fmt"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"slices"
 	"strings"
 )
 
@@ -27,8 +31,8 @@ type ImgPullResp struct {
 	Message        string `json:"message"`
 	ID             string `json:"id"`
 	ProgressDetail struct {
-		Current int `json:"current"`
-		Total   int `json:"total"`
+		Current int64 `json:"current"`
+		Total   int64 `json:"total"`
 	} `json:"progressDetail"`
 }
 
@@ -48,3 +52,99 @@ func (r ImgPullResp) String() string {
 	}
 	return b.String()
 }
+
+type layerProgress struct {
+	current int64
+	total   int64
+	done    bool
+}
+
+// ImgPullProgress aggregates the per layer messages of an image pull.
+type ImgPullProgress struct {
+	layers map[string]*layerProgress
+	status string
+}
+
+func (p *ImgPullProgress) Update(r ImgPullResp) model.ImagePullProgress {
+	if p.layers == nil {
+		p.layers = make(map[string]*layerProgress)
+	}
+	if r.Status != "" {
+		p.status = r.Status
+	}
+	if r.ID != "" {
+		switch r.Status {
+		case "Pulling fs layer", "Waiting":
+			p.getLayer(r.ID)
+		case "Downloading":
+			l := p.getLayer(r.ID)
+			l.current = r.ProgressDetail.Current
+			if r.ProgressDetail.Total > 0 {
+				l.total = r.ProgressDetail.Total
+			}
+		case "Verifying Checksum", "Download complete", "Extracting":
+			l := p.getLayer(r.ID)
+			l.current = l.total
+		case "Pull complete", "Already exists":
+			l := p.getLayer(r.ID)
+			l.current = l.total
+			l.done = true
+		}
+	}
+	return p.Progress()
+}
+
+func (p *ImgPullProgress) Progress() model.ImagePullProgress {
+	prg := model.ImagePullProgress{
+		Status:      p.status,
+		LayersTotal: len(p.layers),
+	}
+	for _, l := range p.layers {
+		if l.done {
+			prg.LayersDone++
+		}
+		prg.BytesCurrent += l.current
+		prg.BytesTotal += l.total
+	}
+	return prg
+}
+
+func (p *ImgPullProgress) getLayer(id string) *layerProgress {
+	l, ok := p.layers[id]
+	if !ok {
+		l = &layerProgress{}
+		p.layers[id] = l
+	}
+	return l
+}
+
+// NormalizeRegistryHost strips scheme and path from a registry address and maps docker hub aliases to 'docker.io'.
+func NormalizeRegistryHost(s string) string {
+	s = strings.TrimPrefix(s, "https://")
+	s = strings.TrimPrefix(s, "http://")
+	s, _, _ = strings.Cut(s, "/")
+	switch s {
+	case "index.docker.io", "registry-1.docker.io":
+		return "docker.io"
+	}
+	return s
+}
+
+// ParsePlatform parses a platform formatted as os/arch[/variant] and normalizes architecture aliases (e.g. aarch64 → arm64).
+func ParsePlatform(s string) (ocispec.Platform, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) < 2 || len(parts) > 3 || slices.Contains(parts, "") {
+		return ocispec.Platform{}, fmt.Errorf("invalid platform '%s'", s)
+	}
+	p, err := platforms.Parse(s)
+	if err != nil {
+		return ocispec.Platform{}, fmt.Errorf("invalid platform '%s': %s", s, err)
+	}
+	return p, nil
+}
+
+// MatchPlatform checks if the platform o can be used for the requested platform p. Both platforms are normalized, hence
+// linux/arm64/v8 matches arm64 images without variant and linux/arm matches arm images with variant v7 or lower.
+func MatchPlatform(p, o ocispec.Platform) bool {
+	return platforms.Only(p).Match(o)
+}
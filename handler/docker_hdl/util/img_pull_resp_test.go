@@ -0,0 +1,236 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestImgPullProgress(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []string
+		want     model.ImagePullProgress
+	}{
+		{
+			name: "no messages",
+		},
+		{
+			name: "status only",
+			messages: []string{
+				`{"status":"Pulling from library/alpine","id":"latest"}`,
+			},
+			want: model.ImagePullProgress{Status: "Pulling from library/alpine"},
+		},
+		{
+			name: "downloading",
+			messages: []string{
+				`{"status":"Pulling fs layer","id":"l1"}`,
+				`{"status":"Waiting","id":"l2"}`,
+				`{"status":"Downloading","id":"l1","progressDetail":{"current":100,"total":1000}}`,
+				`{"status":"Downloading","id":"l2","progressDetail":{"current":50,"total":500}}`,
+				`{"status":"Downloading","id":"l1","progressDetail":{"current":400,"total":1000}}`,
+			},
+			want: model.ImagePullProgress{
+				Status:       "Downloading",
+				LayersTotal:  2,
+				BytesCurrent: 450,
+				BytesTotal:   1500,
+			},
+		},
+		{
+			name: "partially complete",
+			messages: []string{
+				`{"status":"Pulling fs layer","id":"l1"}`,
+				`{"status":"Pulling fs layer","id":"l2"}`,
+				`{"status":"Already exists","id":"l3"}`,
+				`{"status":"Downloading","id":"l1","progressDetail":{"current":100,"total":1000}}`,
+				`{"status":"Downloading","id":"l2","progressDetail":{"current":50,"total":500}}`,
+				`{"status":"Verifying Checksum","id":"l1"}`,
+				`{"status":"Download complete","id":"l1"}`,
+				`{"status":"Extracting","id":"l1","progressDetail":{"current":200,"total":1000}}`,
+				`{"status":"Pull complete","id":"l1"}`,
+			},
+			want: model.ImagePullProgress{
+				Status:       "Pull complete",
+				LayersTotal:  3,
+				LayersDone:   2,
+				BytesCurrent: 1050,
+				BytesTotal:   1500,
+			},
+		},
+		{
+			name: "complete",
+			messages: []string{
+				`{"status":"Pulling fs layer","id":"l1"}`,
+				`{"status":"Downloading","id":"l1","progressDetail":{"current":1000,"total":1000}}`,
+				`{"status":"Pull complete","id":"l1"}`,
+				`{"status":"Digest: sha256:abc"}`,
+				`{"status":"Status: Downloaded newer image for alpine:latest"}`,
+			},
+			want: model.ImagePullProgress{
+				Status:       "Status: Downloaded newer image for alpine:latest",
+				LayersTotal:  1,
+				LayersDone:   1,
+				BytesCurrent: 1000,
+				BytesTotal:   1000,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var p ImgPullProgress
+			got := p.Progress()
+			for _, msg := range tc.messages {
+				var r ImgPullResp
+				if err := json.Unmarshal([]byte(msg), &r); err != nil {
+					t.Fatal(err)
+				}
+				got = p.Update(r)
+			}
+			if got != tc.want {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+			if prg := p.Progress(); prg != got {
+				t.Errorf("expected %+v, got %+v", got, prg)
+			}
+		})
+	}
+}
+
+func TestParsePlatform(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ocispec.Platform
+		wantErr bool
+	}{
+		{input: "linux/amd64", want: ocispec.Platform{OS: "linux", Architecture: "amd64"}},
+		{input: "linux/x86_64", want: ocispec.Platform{OS: "linux", Architecture: "amd64"}},
+		{input: "linux/aarch64", want: ocispec.Platform{OS: "linux", Architecture: "arm64"}},
+		{input: "linux/arm64/v8", want: ocispec.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}},
+		{input: "linux/arm", want: ocispec.Platform{OS: "linux", Architecture: "arm"}},
+		{input: "linux/arm/v7", want: ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}},
+		{input: "Linux/ARM/V6", want: ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v6"}},
+		{input: "", wantErr: true},
+		{input: "linux", wantErr: true},
+		{input: "linux/", wantErr: true},
+		{input: "/amd64", wantErr: true},
+		{input: "linux/arm/", wantErr: true},
+		{input: "linux/arm/v7/extra", wantErr: true},
+		{input: "linux/*", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParsePlatform(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMatchPlatform(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform string
+		image    ocispec.Platform
+		want     bool
+	}{
+		{
+			name:     "equal",
+			platform: "linux/arm/v7",
+			image:    ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+			want:     true,
+		},
+		{
+			name:     "arm64 v8 against empty variant",
+			platform: "linux/arm64/v8",
+			image:    ocispec.Platform{OS: "linux", Architecture: "arm64"},
+			want:     true,
+		},
+		{
+			name:     "arm64 against v8",
+			platform: "linux/arm64",
+			image:    ocispec.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"},
+			want:     true,
+		},
+		{
+			name:     "arm against v7",
+			platform: "linux/arm",
+			image:    ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+			want:     true,
+		},
+		{
+			name:     "arm v7 against v6",
+			platform: "linux/arm/v7",
+			image:    ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v6"},
+			want:     true,
+		},
+		{
+			name:     "arm v6 against v7",
+			platform: "linux/arm/v6",
+			image:    ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+		},
+		{
+			name:     "amd64 alias",
+			platform: "linux/amd64",
+			image:    ocispec.Platform{OS: "linux", Architecture: "x86_64"},
+			want:     true,
+		},
+		{
+			name:     "arm64 alias",
+			platform: "linux/aarch64",
+			image:    ocispec.Platform{OS: "Linux", Architecture: "arm64"},
+			want:     true,
+		},
+		{
+			name:     "arch mismatch",
+			platform: "linux/amd64",
+			image:    ocispec.Platform{OS: "linux", Architecture: "arm64"},
+		},
+		{
+			name:     "os mismatch",
+			platform: "linux/amd64",
+			image:    ocispec.Platform{OS: "windows", Architecture: "amd64"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParsePlatform(tc.platform)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := MatchPlatform(p, tc.image); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
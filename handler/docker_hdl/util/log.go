@@ -17,14 +17,23 @@
 package util
 
 import (
+	"bytes"
 	"encoding/binary"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"io"
+	"strings"
 )
 
 type RCWrapper struct {
 	ReadCloser     io.ReadCloser
 	notMultiplexed bool
+	checked        bool
+	header         [8]byte
+	streamType     byte
+	pending        []byte
 	remainder      int
 }
 
@@ -32,62 +41,165 @@ func (c *RCWrapper) Close() error {
 	return c.ReadCloser.Close()
 }
 
+// Stream returns the stream of the data returned by the last call to Read.
+func (c *RCWrapper) Stream() model.LogStream {
+	if c.streamType == 2 {
+		return model.StderrStream
+	}
+	return model.StdoutStream
+}
+
+// Read returns the payload of multiplexed frames as soon as it is available.
+// Streams without multiplex headers (e.g. containers with a pseudo TTY) are passed through unchanged.
 func (c *RCWrapper) Read(p []byte) (n int, err error) {
-	pLen := len(p)
-	if !c.notMultiplexed {
-		var pRemainder int
-		for n < pLen {
-			pRemainder = pLen - n
-			var size int
-			var n2 int
-			if c.remainder == 0 {
-				header := make([]byte, 8)
-				if n2, err = c.ReadCloser.Read(header); err != nil {
-					break
-				}
-				streamType := header[0]
-				if streamType < 1 || streamType > 2 {
-					if !c.notMultiplexed {
-						c.notMultiplexed = true
-						n = n2
-						copy(p, header)
-						break
-					}
-					err = fmt.Errorf("unkown stream type '%d'", streamType)
-					break
-				}
-				outputLen := int(binary.BigEndian.Uint32(header[4:]))
-				if outputLen < pRemainder {
-					size = outputLen
-				} else {
-					size = pRemainder
-					c.remainder = outputLen - pRemainder
-				}
-			} else {
-				if c.remainder < pRemainder {
-					size = c.remainder
-					c.remainder = 0
+	if c.notMultiplexed {
+		if len(c.pending) > 0 {
+			n = copy(p, c.pending)
+			c.pending = c.pending[n:]
+			return
+		}
+		return c.ReadCloser.Read(p)
+	}
+	if c.remainder == 0 {
+		var hn int
+		hn, err = io.ReadFull(c.ReadCloser, c.header[:])
+		if hn == 0 {
+			return
+		}
+		streamType := c.header[0]
+		if err != nil || streamType < 1 || streamType > 2 {
+			if !c.checked {
+				c.notMultiplexed = true
+				c.pending = c.header[:hn]
+				n = copy(p, c.pending)
+				c.pending = c.pending[n:]
+				if errors.Is(err, io.ErrUnexpectedEOF) && len(c.pending) == 0 {
+					err = io.EOF
 				} else {
-					size = pRemainder
-					c.remainder = c.remainder - pRemainder
+					err = nil
 				}
+				return
 			}
-			n2, err = c.ReadCloser.Read(p[n : n+size])
-			n += n2
-			if err != nil {
-				break
+			if err == nil {
+				err = fmt.Errorf("unkown stream type '%d'", streamType)
 			}
+			return 0, err
+		}
+		c.checked = true
+		c.streamType = streamType
+		c.remainder = int(binary.BigEndian.Uint32(c.header[4:]))
+		if c.remainder == 0 {
+			return
 		}
 	}
-	if c.notMultiplexed {
-		for n < pLen {
-			var n2 int
-			n2, err = c.ReadCloser.Read(p[n:pLen])
-			n += n2
-			if err != nil {
-				break
+	size := len(p)
+	if c.remainder < size {
+		size = c.remainder
+	}
+	n, err = c.ReadCloser.Read(p[:size])
+	c.remainder -= n
+	if errors.Is(err, io.EOF) && c.remainder > 0 {
+		err = io.ErrUnexpectedEOF
+	}
+	return
+}
+
+const ndjsonMaxLineSize = 16384
+
+// NDJSONWrapper converts the output of RCWrapper to newline delimited model.LogEntry objects.
+// Lines must be prefixed with timestamps (see container.LogsOptions.Timestamps).
+// Lines exceeding ndjsonMaxLineSize are split into multiple entries.
+type NDJSONWrapper struct {
+	RCWrapper *RCWrapper
+	partial   [2]bytes.Buffer
+	out       bytes.Buffer
+	chunk     []byte
+	err       error
+}
+
+func (w *NDJSONWrapper) Close() error {
+	return w.RCWrapper.Close()
+}
+
+func (w *NDJSONWrapper) Read(p []byte) (n int, err error) {
+	if w.chunk == nil {
+		w.chunk = make([]byte, 32768)
+	}
+	for w.out.Len() == 0 && w.err == nil {
+		n2, rErr := w.RCWrapper.Read(w.chunk)
+		if n2 > 0 {
+			if err = w.process(w.RCWrapper.Stream(), w.chunk[:n2]); err != nil {
+				return 0, err
+			}
+		}
+		if rErr != nil {
+			w.err = rErr
+			for _, stream := range []model.LogStream{model.StdoutStream, model.StderrStream} {
+				if buf := w.partialBuffer(stream); buf.Len() > 0 {
+					if err = w.writeEntry(stream, buf.String()); err != nil {
+						return 0, err
+					}
+					buf.Reset()
+				}
 			}
 		}
 	}
-	return
+	if w.out.Len() > 0 {
+		return w.out.Read(p)
+	}
+	return 0, w.err
+}
+
+func (w *NDJSONWrapper) partialBuffer(stream model.LogStream) *bytes.Buffer {
+	if stream == model.StderrStream {
+		return &w.partial[1]
+	}
+	return &w.partial[0]
+}
+
+func (w *NDJSONWrapper) process(stream model.LogStream, b []byte) error {
+	buf := w.partialBuffer(stream)
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, '\n')
+		complete := i >= 0
+		if !complete {
+			i = len(b)
+		}
+		if free := ndjsonMaxLineSize - buf.Len(); i > free {
+			i = free
+			complete = false
+		}
+		buf.Write(b[:i])
+		b = b[i:]
+		if complete {
+			b = b[1:]
+		} else if buf.Len() < ndjsonMaxLineSize {
+			return nil
+		}
+		if err := w.writeEntry(stream, buf.String()); err != nil {
+			return err
+		}
+		buf.Reset()
+	}
+	return nil
+}
+
+func (w *NDJSONWrapper) writeEntry(stream model.LogStream, s string) error {
+	entry := model.LogEntry{
+		Stream: stream,
+		Line:   s,
+	}
+	if ts, line, ok := strings.Cut(s, " "); ok {
+		if t, err := ParseTimestamp(ts); err == nil {
+			entry.Timestamp = t
+			entry.Line = line
+		}
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	w.out.Write(b)
+	w.out.WriteByte('\n')
+	return nil
 }
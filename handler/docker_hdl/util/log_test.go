@@ -0,0 +1,273 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+type testFrame struct {
+	stream stdcopy.StdType
+	data   string
+}
+
+func genMultiplexed(t *testing.T, frames []testFrame) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	for _, f := range frames {
+		if _, err := stdcopy.NewStdWriter(&buf, f.stream).Write([]byte(f.data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestRCWrapper(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   func(t *testing.T) []byte
+		bufSize int
+		want    map[model.LogStream]string
+		wantErr bool
+	}{
+		{
+			name: "multiplexed",
+			input: func(t *testing.T) []byte {
+				return genMultiplexed(t, []testFrame{
+					{stream: stdcopy.Stdout, data: "out 1\n"},
+					{stream: stdcopy.Stderr, data: "err 1\n"},
+					{stream: stdcopy.Stdout, data: "out 2\n"},
+				})
+			},
+			bufSize: 1024,
+			want: map[model.LogStream]string{
+				model.StdoutStream: "out 1\nout 2\n",
+				model.StderrStream: "err 1\n",
+			},
+		},
+		{
+			name: "multiplexed small buffer",
+			input: func(t *testing.T) []byte {
+				return genMultiplexed(t, []testFrame{
+					{stream: stdcopy.Stderr, data: "a longer line on stderr\n"},
+					{stream: stdcopy.Stdout, data: "a longer line on stdout\n"},
+				})
+			},
+			bufSize: 3,
+			want: map[model.LogStream]string{
+				model.StdoutStream: "a longer line on stdout\n",
+				model.StderrStream: "a longer line on stderr\n",
+			},
+		},
+		{
+			name: "not multiplexed",
+			input: func(t *testing.T) []byte {
+				return []byte("plain output\nfrom a tty\n")
+			},
+			bufSize: 1024,
+			want: map[model.LogStream]string{
+				model.StdoutStream: "plain output\nfrom a tty\n",
+			},
+		},
+		{
+			name: "not multiplexed shorter than header",
+			input: func(t *testing.T) []byte {
+				return []byte("tty")
+			},
+			bufSize: 1024,
+			want: map[model.LogStream]string{
+				model.StdoutStream: "tty",
+			},
+		},
+		{
+			name: "truncated frame",
+			input: func(t *testing.T) []byte {
+				b := genMultiplexed(t, []testFrame{{stream: stdcopy.Stdout, data: "out 1\n"}})
+				return b[:len(b)-2]
+			},
+			bufSize: 1024,
+			wantErr: true,
+		},
+		{
+			name: "invalid stream type after first frame",
+			input: func(t *testing.T) []byte {
+				b := genMultiplexed(t, []testFrame{{stream: stdcopy.Stdout, data: "out 1\n"}})
+				return append(b, 5, 0, 0, 0, 0, 0, 0, 1, 'x')
+			},
+			bufSize: 1024,
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rc := &RCWrapper{ReadCloser: io.NopCloser(bytes.NewReader(tc.input(t)))}
+			got := make(map[model.LogStream]string)
+			p := make([]byte, tc.bufSize)
+			var err error
+			for {
+				var n int
+				n, err = rc.Read(p)
+				if n > 0 {
+					got[rc.Stream()] += string(p[:n])
+				}
+				if err != nil {
+					break
+				}
+			}
+			if tc.wantErr {
+				if errors.Is(err, io.EOF) {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if !errors.Is(err, io.EOF) {
+				t.Fatal(err)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+			for stream, s := range tc.want {
+				if got[stream] != s {
+					t.Errorf("stream '%s': expected %q, got %q", stream, s, got[stream])
+				}
+			}
+		})
+	}
+}
+
+func TestNDJSONWrapper(t *testing.T) {
+	ts1 := time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	ts2 := time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC)
+	prefix := ts1.Format(time.RFC3339Nano) + " "
+	long := strings.Repeat("x", ndjsonMaxLineSize)
+	tests := []struct {
+		name  string
+		input []testFrame
+		want  []model.LogEntry
+	}{
+		{
+			name: "single lines",
+			input: []testFrame{
+				{stream: stdcopy.Stdout, data: ts1.Format(time.RFC3339Nano) + " hello\n"},
+				{stream: stdcopy.Stderr, data: ts2.Format(time.RFC3339Nano) + " world\n"},
+			},
+			want: []model.LogEntry{
+				{Stream: model.StdoutStream, Timestamp: ts1, Line: "hello"},
+				{Stream: model.StderrStream, Timestamp: ts2, Line: "world"},
+			},
+		},
+		{
+			name: "line split across frames",
+			input: []testFrame{
+				{stream: stdcopy.Stderr, data: ts1.Format(time.RFC3339Nano) + " split "},
+				{stream: stdcopy.Stdout, data: ts2.Format(time.RFC3339Nano) + " other\n"},
+				{stream: stdcopy.Stderr, data: "line\n"},
+			},
+			want: []model.LogEntry{
+				{Stream: model.StdoutStream, Timestamp: ts2, Line: "other"},
+				{Stream: model.StderrStream, Timestamp: ts1, Line: "split line"},
+			},
+		},
+		{
+			name: "multiple lines in frame and missing trailing newline",
+			input: []testFrame{
+				{stream: stdcopy.Stdout, data: ts1.Format(time.RFC3339Nano) + " a\n" + ts2.Format(time.RFC3339Nano) + " b"},
+			},
+			want: []model.LogEntry{
+				{Stream: model.StdoutStream, Timestamp: ts1, Line: "a"},
+				{Stream: model.StdoutStream, Timestamp: ts2, Line: "b"},
+			},
+		},
+		{
+			name: "without timestamp",
+			input: []testFrame{
+				{stream: stdcopy.Stdout, data: "no timestamp\n"},
+			},
+			want: []model.LogEntry{
+				{Stream: model.StdoutStream, Line: "no timestamp"},
+			},
+		},
+		{
+			name: "missing trailing newlines flushed in stream order",
+			input: []testFrame{
+				{stream: stdcopy.Stderr, data: ts1.Format(time.RFC3339Nano) + " err"},
+				{stream: stdcopy.Stdout, data: ts2.Format(time.RFC3339Nano) + " out"},
+			},
+			want: []model.LogEntry{
+				{Stream: model.StdoutStream, Timestamp: ts2, Line: "out"},
+				{Stream: model.StderrStream, Timestamp: ts1, Line: "err"},
+			},
+		},
+		{
+			name: "line exceeding max size",
+			input: []testFrame{
+				{stream: stdcopy.Stdout, data: prefix + long[:100]},
+				{stream: stdcopy.Stdout, data: long[100:] + "\n"},
+			},
+			want: []model.LogEntry{
+				{Stream: model.StdoutStream, Timestamp: ts1, Line: long[:ndjsonMaxLineSize-len(prefix)]},
+				{Stream: model.StdoutStream, Line: long[ndjsonMaxLineSize-len(prefix):]},
+			},
+		},
+		{
+			name: "line of exactly max size",
+			input: []testFrame{
+				{stream: stdcopy.Stdout, data: prefix + long[len(prefix):] + "\n" + prefix + "next\n"},
+			},
+			want: []model.LogEntry{
+				{Stream: model.StdoutStream, Timestamp: ts1, Line: long[len(prefix):]},
+				{Stream: model.StdoutStream, Timestamp: ts1, Line: "next"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &NDJSONWrapper{RCWrapper: &RCWrapper{ReadCloser: io.NopCloser(bytes.NewReader(genMultiplexed(t, tc.input)))}}
+			b, err := io.ReadAll(w)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []model.LogEntry
+			scanner := bufio.NewScanner(bytes.NewReader(b))
+			for scanner.Scan() {
+				var entry model.LogEntry
+				if err = json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, entry)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected %d entries, got %d: %s", len(tc.want), len(got), b)
+			}
+			for i, entry := range tc.want {
+				if got[i].Stream != entry.Stream || got[i].Line != entry.Line || !got[i].Timestamp.Equal(entry.Timestamp) {
+					t.Errorf("entry %d: expected %+v, got %+v", i, entry, got[i])
+				}
+			}
+		})
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/go-connections/nat"
@@ -36,6 +38,7 @@ func ParseEndpointSettings(endptSettings map[string]*network.EndpointSettings) (
 				ID:          val.NetworkID,
 				Name:        key,
 				IPAddress:   model.IPAddr(net.ParseIP(val.IPAddress)),
+				IPv6Address: model.IPAddr(net.ParseIP(val.GlobalIPv6Address)),
 				Gateway:     model.IPAddr(net.ParseIP(val.Gateway)),
 				DomainNames: val.Aliases,
 				MacAddress:  val.MacAddress,
@@ -119,6 +122,25 @@ func ParseMounts(mts []mount.Mount) (mounts []model.Mount) {
 	return
 }
 
+func ParseImageHistory(items []image.HistoryResponseItem) []model.ImageHistoryItem {
+	history := make([]model.ImageHistoryItem, 0, len(items))
+	for _, item := range items {
+		hi := model.ImageHistoryItem{
+			ID:        item.ID,
+			Created:   time.Unix(item.Created, 0).UTC(),
+			CreatedBy: item.CreatedBy,
+			Size:      item.Size,
+			Comment:   item.Comment,
+			Tags:      item.Tags,
+		}
+		if hi.ID == "<missing>" {
+			hi.ID = ""
+		}
+		history = append(history, hi)
+	}
+	return history
+}
+
 func ParseEnv(ev []string) (env map[string]string) {
 	if len(ev) > 0 {
 		env = make(map[string]string, len(ev))
@@ -138,19 +160,106 @@ func ParseStopTimeout(t *int) *time.Duration {
 	return nil
 }
 
-func ParseNetIPAMConfig(c []network.IPAMConfig) (s model.Subnet, gw model.IPAddr) {
-	if c != nil && len(c) > 0 {
-		sp := strings.Split(c[0].Subnet, "/")
-		if len(sp) == 2 {
-			s.Prefix = model.IPAddr(net.ParseIP(sp[0]))
-			i, _ := strconv.ParseInt(sp[1], 10, 0)
-			s.Bits = int(i)
+func ParseNetIPAMConfig(c []network.IPAMConfig) (configs []model.IPAMConfig) {
+	for _, config := range c {
+		ipamConfig := model.IPAMConfig{
+			Subnet:  parseSubnet(config.Subnet),
+			IPRange: parseSubnet(config.IPRange),
+		}
+		if config.Gateway != "" {
+			ipamConfig.Gateway = model.IPAddr(net.ParseIP(config.Gateway))
+		}
+		if len(config.AuxAddress) > 0 {
+			ipamConfig.AuxAddresses = make(map[string]model.IPAddr)
+			for name, addr := range config.AuxAddress {
+				ipamConfig.AuxAddresses[name] = model.IPAddr(net.ParseIP(addr))
+			}
+		}
+		configs = append(configs, ipamConfig)
+	}
+	return
+}
+
+func ParseNetContainers(endpoints map[string]network.EndpointResource) (containers []model.NetworkContainer) {
+	for id, endpoint := range endpoints {
+		containers = append(containers, model.NetworkContainer{
+			ID:          id,
+			Name:        endpoint.Name,
+			IPAddress:   parseCIDRAddr(endpoint.IPv4Address),
+			IPv6Address: parseCIDRAddr(endpoint.IPv6Address),
+			MacAddress:  endpoint.MacAddress,
+		})
+	}
+	return
+}
+
+// GetNetSubnetAndGateway returns subnet and gateway of the first IPv4 config or the first config if no IPv4 config exists.
+func GetNetSubnetAndGateway(configs []model.IPAMConfig) (model.Subnet, model.IPAddr) {
+	for _, config := range configs {
+		if net.IP(config.Subnet.Prefix).To4() != nil {
+			return config.Subnet, config.Gateway
 		}
-		gw = model.IPAddr(net.ParseIP(c[0].Gateway))
+	}
+	if len(configs) > 0 {
+		return configs[0].Subnet, configs[0].Gateway
+	}
+	return model.Subnet{}, nil
+}
+
+func ParseNetDriverOptions(nType model.NetworkType, opts map[string]string) (parent string, mode model.NetworkMode) {
+	switch nType {
+	case model.MACVlanNet:
+		return opts[netParentOpt], opts[netMACVlanModeOpt]
+	case model.IPVlanNet:
+		return opts[netParentOpt], opts[netIPVlanModeOpt]
+	}
+	return
+}
+
+// ParseVolumeDriverOptions returns a copy of the options with credentials contained in the mount options redacted.
+func ParseVolumeDriverOptions(opts map[string]string) map[string]string {
+	if opts == nil {
+		return nil
+	}
+	vOpts := make(map[string]string, len(opts))
+	for key, val := range opts {
+		if key == volMountOpt {
+			val = redactMountOptions(val)
+		}
+		vOpts[key] = val
+	}
+	return vOpts
+}
+
+func redactMountOptions(s string) string {
+	parts := strings.Split(s, ",")
+	for i, part := range parts {
+		key, _, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		if _, ok = volSensitiveMountOpts[strings.ToLower(key)]; ok {
+			parts[i] = key + "=" + redactedValue
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+func parseSubnet(s string) (subnet model.Subnet) {
+	if _, ipNet, err := net.ParseCIDR(s); err == nil {
+		subnet.Prefix = model.IPAddr(ipNet.IP)
+		subnet.Bits, _ = ipNet.Mask.Size()
 	}
 	return
 }
 
+func parseCIDRAddr(s string) model.IPAddr {
+	if ip, _, err := net.ParseCIDR(s); err == nil {
+		return model.IPAddr(ip)
+	}
+	return nil
+}
+
 func ParseTimestamp(s string) (time.Time, error) {
 	t, err := time.Parse(time.RFC3339Nano, s)
 	return t.UTC(), err
@@ -175,3 +284,101 @@ func ParseRestartPolicy(rp container.RestartPolicy) (strategy model.RestartStrat
 	}
 	return
 }
+
+func ParseContainerStats(s container.StatsResponse) model.ContainerStats {
+	stats := model.ContainerStats{
+		Time: s.Read.UTC(),
+		PIDs: s.PidsStats.Current,
+		Memory: model.MemoryStats{
+			Usage: s.MemoryStats.Usage,
+			Limit: s.MemoryStats.Limit,
+		},
+	}
+	cpuDelta := float64(s.CPUStats.CPUUsage.TotalUsage) - float64(s.PreCPUStats.CPUUsage.TotalUsage)
+	sysDelta := float64(s.CPUStats.SystemUsage) - float64(s.PreCPUStats.SystemUsage)
+	onlineCPUs := float64(s.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(s.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if cpuDelta > 0 && sysDelta > 0 {
+		stats.CPUPercent = (cpuDelta / sysDelta) * onlineCPUs * 100
+	}
+	// page cache is excluded from usage, cgroup v1 reports "total_inactive_file" and cgroup v2 "inactive_file"
+	if v, ok := s.MemoryStats.Stats["total_inactive_file"]; ok && v < stats.Memory.Usage {
+		stats.Memory.Usage -= v
+	} else if v, ok = s.MemoryStats.Stats["inactive_file"]; ok && v < stats.Memory.Usage {
+		stats.Memory.Usage -= v
+	}
+	if stats.Memory.Limit > 0 {
+		stats.Memory.Percent = float64(stats.Memory.Usage) / float64(stats.Memory.Limit) * 100
+	}
+	if len(s.Networks) > 0 {
+		stats.Networks = make(map[string]model.NetworkStats, len(s.Networks))
+		for key, val := range s.Networks {
+			stats.Networks[key] = model.NetworkStats{
+				RxBytes: val.RxBytes,
+				TxBytes: val.TxBytes,
+			}
+		}
+	}
+	for _, entry := range s.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			stats.BlockIO.ReadBytes += entry.Value
+		case "write":
+			stats.BlockIO.WriteBytes += entry.Value
+		}
+	}
+	return stats
+}
+
+func ParseEvent(msg events.Message) (model.Event, bool) {
+	eType, ok := EventTypeMap[msg.Type]
+	if !ok {
+		return model.Event{}, false
+	}
+	action, status, _ := strings.Cut(string(msg.Action), ":")
+	event := model.Event{
+		Type:       eType,
+		Action:     action,
+		ID:         msg.Actor.ID,
+		Name:       msg.Actor.Attributes["name"],
+		Attributes: msg.Actor.Attributes,
+		Time:       time.Unix(0, msg.TimeNano).UTC(),
+	}
+	if eType == model.ContainerEvent {
+		if state, ok := EventStateMap[msg.Action]; ok {
+			event.State = &state
+		}
+		if events.Action(action) == events.ActionHealthStatus {
+			if health, ok := HealthMap[strings.TrimSpace(status)]; ok {
+				event.Health = &health
+			}
+		}
+	}
+	return event, true
+}
+
+func ParseResources(r container.Resources) model.Resources {
+	res := model.Resources{
+		Memory:            r.Memory,
+		MemoryReservation: r.MemoryReservation,
+		MemorySwap:        r.MemorySwap,
+		CPUs:              float64(r.NanoCPUs) / 1e9,
+		CPUShares:         r.CPUShares,
+		CPUSet:            r.CpusetCpus,
+	}
+	if r.PidsLimit != nil {
+		res.PidsLimit = *r.PidsLimit
+	}
+	for _, u := range r.Ulimits {
+		if u != nil {
+			res.Ulimits = append(res.Ulimits, model.Ulimit{
+				Name: u.Name,
+				Soft: u.Soft,
+				Hard: u.Hard,
+			})
+		}
+	}
+	return res
+}
@@ -0,0 +1,386 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/network"
+)
+
+func TestParseContainerStats(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name  string
+		input container.StatsResponse
+		want  model.ContainerStats
+	}{
+		{
+			name:  "empty",
+			input: container.StatsResponse{Stats: container.Stats{Read: ts}},
+			want:  model.ContainerStats{Time: ts},
+		},
+		{
+			name: "cpu with online cpus",
+			input: container.StatsResponse{
+				Stats: container.Stats{
+					Read: ts,
+					CPUStats: container.CPUStats{
+						CPUUsage:    container.CPUUsage{TotalUsage: 300},
+						SystemUsage: 2000,
+						OnlineCPUs:  4,
+					},
+					PreCPUStats: container.CPUStats{
+						CPUUsage:    container.CPUUsage{TotalUsage: 100},
+						SystemUsage: 1000,
+					},
+				},
+			},
+			want: model.ContainerStats{Time: ts, CPUPercent: 80},
+		},
+		{
+			name: "cpu with per cpu usage",
+			input: container.StatsResponse{
+				Stats: container.Stats{
+					Read: ts,
+					CPUStats: container.CPUStats{
+						CPUUsage:    container.CPUUsage{TotalUsage: 300, PercpuUsage: []uint64{150, 150}},
+						SystemUsage: 2000,
+					},
+					PreCPUStats: container.CPUStats{
+						CPUUsage:    container.CPUUsage{TotalUsage: 100},
+						SystemUsage: 1000,
+					},
+				},
+			},
+			want: model.ContainerStats{Time: ts, CPUPercent: 40},
+		},
+		{
+			name: "cpu without system delta",
+			input: container.StatsResponse{
+				Stats: container.Stats{
+					Read: ts,
+					CPUStats: container.CPUStats{
+						CPUUsage:    container.CPUUsage{TotalUsage: 300},
+						SystemUsage: 1000,
+						OnlineCPUs:  1,
+					},
+					PreCPUStats: container.CPUStats{
+						CPUUsage:    container.CPUUsage{TotalUsage: 100},
+						SystemUsage: 1000,
+					},
+				},
+			},
+			want: model.ContainerStats{Time: ts},
+		},
+		{
+			name: "memory cgroup v1",
+			input: container.StatsResponse{
+				Stats: container.Stats{
+					Read: ts,
+					MemoryStats: container.MemoryStats{
+						Usage: 1000,
+						Limit: 4000,
+						Stats: map[string]uint64{"total_inactive_file": 200, "inactive_file": 100},
+					},
+				},
+			},
+			want: model.ContainerStats{Time: ts, Memory: model.MemoryStats{Usage: 800, Limit: 4000, Percent: 20}},
+		},
+		{
+			name: "memory cgroup v2",
+			input: container.StatsResponse{
+				Stats: container.Stats{
+					Read: ts,
+					MemoryStats: container.MemoryStats{
+						Usage: 1000,
+						Limit: 2000,
+						Stats: map[string]uint64{"inactive_file": 500},
+					},
+				},
+			},
+			want: model.ContainerStats{Time: ts, Memory: model.MemoryStats{Usage: 500, Limit: 2000, Percent: 25}},
+		},
+		{
+			name: "memory inactive file exceeds usage",
+			input: container.StatsResponse{
+				Stats: container.Stats{
+					Read: ts,
+					MemoryStats: container.MemoryStats{
+						Usage: 100,
+						Stats: map[string]uint64{"inactive_file": 500},
+					},
+				},
+			},
+			want: model.ContainerStats{Time: ts, Memory: model.MemoryStats{Usage: 100}},
+		},
+		{
+			name: "networks, block io and pids",
+			input: container.StatsResponse{
+				Networks: map[string]container.NetworkStats{
+					"eth0": {RxBytes: 10, TxBytes: 20},
+					"eth1": {RxBytes: 30, TxBytes: 40},
+				},
+				Stats: container.Stats{
+					Read:      ts,
+					PidsStats: container.PidsStats{Current: 7},
+					BlkioStats: container.BlkioStats{
+						IoServiceBytesRecursive: []container.BlkioStatEntry{
+							{Op: "Read", Value: 100},
+							{Op: "write", Value: 200},
+							{Op: "read", Value: 50},
+							{Op: "Total", Value: 350},
+						},
+					},
+				},
+			},
+			want: model.ContainerStats{
+				Time: ts,
+				PIDs: 7,
+				Networks: map[string]model.NetworkStats{
+					"eth0": {RxBytes: 10, TxBytes: 20},
+					"eth1": {RxBytes: 30, TxBytes: 40},
+				},
+				BlockIO: model.BlockIOStats{ReadBytes: 150, WriteBytes: 200},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ParseContainerStats(tc.input)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	running := model.RunningState
+	stopped := model.StoppedState
+	unhealthy := model.UnhealthyState
+	tests := []struct {
+		name   string
+		input  events.Message
+		want   model.Event
+		wantOk bool
+	}{
+		{
+			name: "container start",
+			input: events.Message{
+				Type:     events.ContainerEventType,
+				Action:   events.ActionStart,
+				Actor:    events.Actor{ID: "c1", Attributes: map[string]string{"name": "ctr", "image": "img"}},
+				TimeNano: ts.UnixNano(),
+			},
+			want: model.Event{
+				Type:       model.ContainerEvent,
+				Action:     "start",
+				ID:         "c1",
+				Name:       "ctr",
+				State:      &running,
+				Attributes: map[string]string{"name": "ctr", "image": "img"},
+				Time:       ts,
+			},
+			wantOk: true,
+		},
+		{
+			name: "container die",
+			input: events.Message{
+				Type:     events.ContainerEventType,
+				Action:   events.ActionDie,
+				Actor:    events.Actor{ID: "c1"},
+				TimeNano: ts.UnixNano(),
+			},
+			want: model.Event{
+				Type:   model.ContainerEvent,
+				Action: "die",
+				ID:     "c1",
+				State:  &stopped,
+				Time:   ts,
+			},
+			wantOk: true,
+		},
+		{
+			name: "container health status",
+			input: events.Message{
+				Type:     events.ContainerEventType,
+				Action:   events.ActionHealthStatusUnhealthy,
+				Actor:    events.Actor{ID: "c1"},
+				TimeNano: ts.UnixNano(),
+			},
+			want: model.Event{
+				Type:   model.ContainerEvent,
+				Action: "health_status",
+				ID:     "c1",
+				Health: &unhealthy,
+				Time:   ts,
+			},
+			wantOk: true,
+		},
+		{
+			name: "container exec",
+			input: events.Message{
+				Type:     events.ContainerEventType,
+				Action:   events.ActionExecStart + ": sh -c true",
+				Actor:    events.Actor{ID: "c1"},
+				TimeNano: ts.UnixNano(),
+			},
+			want: model.Event{
+				Type:   model.ContainerEvent,
+				Action: "exec_start",
+				ID:     "c1",
+				Time:   ts,
+			},
+			wantOk: true,
+		},
+		{
+			name: "network connect",
+			input: events.Message{
+				Type:     events.NetworkEventType,
+				Action:   events.ActionConnect,
+				Actor:    events.Actor{ID: "n1", Attributes: map[string]string{"name": "net", "container": "c1"}},
+				TimeNano: ts.UnixNano(),
+			},
+			want: model.Event{
+				Type:       model.NetworkEvent,
+				Action:     "connect",
+				ID:         "n1",
+				Name:       "net",
+				Attributes: map[string]string{"name": "net", "container": "c1"},
+				Time:       ts,
+			},
+			wantOk: true,
+		},
+		{
+			name: "unsupported type",
+			input: events.Message{
+				Type:   events.PluginEventType,
+				Action: events.ActionEnable,
+				Actor:  events.Actor{ID: "p1"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := ParseEvent(tc.input)
+			if ok != tc.wantOk {
+				t.Fatalf("expected ok=%v, got %v", tc.wantOk, ok)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseNetIPAMConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []network.IPAMConfig
+		want  []model.IPAMConfig
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "subnet only",
+			input: []network.IPAMConfig{{Subnet: "10.0.0.0/24"}},
+			want:  []model.IPAMConfig{{Subnet: model.Subnet{Prefix: model.IPAddr(net.IPv4(10, 0, 0, 0).To4()), Bits: 24}}},
+		},
+		{
+			name: "all fields",
+			input: []network.IPAMConfig{{
+				Subnet:     "10.0.0.0/24",
+				IPRange:    "10.0.0.128/25",
+				Gateway:    "10.0.0.1",
+				AuxAddress: map[string]string{"host": "10.0.0.2"},
+			}},
+			want: []model.IPAMConfig{{
+				Subnet:       model.Subnet{Prefix: model.IPAddr(net.IPv4(10, 0, 0, 0).To4()), Bits: 24},
+				IPRange:      model.Subnet{Prefix: model.IPAddr(net.IPv4(10, 0, 0, 128).To4()), Bits: 25},
+				Gateway:      model.IPAddr(net.ParseIP("10.0.0.1")),
+				AuxAddresses: map[string]model.IPAddr{"host": model.IPAddr(net.ParseIP("10.0.0.2"))},
+			}},
+		},
+		{
+			name:  "ipv6",
+			input: []network.IPAMConfig{{Subnet: "fd00::/64", Gateway: "fd00::1"}},
+			want:  []model.IPAMConfig{{Subnet: model.Subnet{Prefix: model.IPAddr(net.ParseIP("fd00::")), Bits: 64}, Gateway: model.IPAddr(net.ParseIP("fd00::1"))}},
+		},
+		{
+			name:  "invalid values",
+			input: []network.IPAMConfig{{Subnet: "invalid", IPRange: "10.0.0.0", Gateway: "invalid"}},
+			want:  []model.IPAMConfig{{}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ParseNetIPAMConfig(tc.input)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseVolumeDriverOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input map[string]string
+		want  map[string]string
+	}{
+		{
+			name:  "nil",
+			input: nil,
+			want:  nil,
+		},
+		{
+			name: "cifs credentials",
+			input: map[string]string{
+				"type":   "cifs",
+				"device": "//10.0.0.2/share",
+				"o":      "addr=10.0.0.2,username=test,Password=secret,vers=3.0,ro",
+			},
+			want: map[string]string{
+				"type":   "cifs",
+				"device": "//10.0.0.2/share",
+				"o":      "addr=10.0.0.2,username=<redacted>,Password=<redacted>,vers=3.0,ro",
+			},
+		},
+		{
+			name:  "no credentials",
+			input: map[string]string{"type": "tmpfs", "o": "size=100m,uid=1000"},
+			want:  map[string]string{"type": "tmpfs", "o": "size=100m,uid=1000"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ParseVolumeDriverOptions(tc.input)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
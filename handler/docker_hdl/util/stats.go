@@ -0,0 +1,54 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/docker/docker/api/types/container"
+	"io"
+)
+
+// StatsWrapper converts a stream of engine stats to newline delimited model.ContainerStats objects.
+type StatsWrapper struct {
+	ReadCloser io.ReadCloser
+	decoder    *json.Decoder
+	out        bytes.Buffer
+}
+
+func (w *StatsWrapper) Close() error {
+	return w.ReadCloser.Close()
+}
+
+func (w *StatsWrapper) Read(p []byte) (int, error) {
+	if w.out.Len() == 0 {
+		if w.decoder == nil {
+			w.decoder = json.NewDecoder(w.ReadCloser)
+		}
+		var s container.StatsResponse
+		if err := w.decoder.Decode(&s); err != nil {
+			return 0, err
+		}
+		b, err := json.Marshal(ParseContainerStats(s))
+		if err != nil {
+			return 0, err
+		}
+		w.out.Write(b)
+		w.out.WriteByte('\n')
+	}
+	return w.out.Read(p)
+}
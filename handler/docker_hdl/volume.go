@@ -18,11 +18,14 @@ package docker_hdl
 
 import (
 	"context"
+	"fmt"
 	hdl_util "github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/docker_hdl/util"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/util"
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
 )
 
 func (h *Handler) ListVolumes(ctx context.Context, filter model.VolumeFilter) ([]model.Volume, error) {
@@ -31,23 +34,19 @@ func (h *Handler) ListVolumes(ctx context.Context, filter model.VolumeFilter) ([
 	if err != nil {
 		return nil, model.NewInternalError(err)
 	}
-	for _, vl := range vls.Volumes {
-		vol := model.Volume{
-			Name:   vl.Name,
-			Labels: vl.Labels,
-		}
-		if ti, err := hdl_util.ParseTimestamp(vl.CreatedAt); err != nil {
-			util.Logger.Errorf("parsing created timestamp for volume '%s' failed: %s", vl.Name, err)
-		} else {
-			vol.Created = ti
+	var usage map[string]*volume.UsageData
+	if filter.Usage {
+		if usage, err = h.getVolumeUsage(ctx); err != nil {
+			return nil, err
 		}
-		vols = append(vols, vol)
+	}
+	for _, vl := range vls.Volumes {
+		vols = append(vols, newVolume(*vl, usage))
 	}
 	return vols, nil
 }
 
-func (h *Handler) VolumeInfo(ctx context.Context, id string) (model.Volume, error) {
-	vol := model.Volume{}
+func (h *Handler) VolumeInfo(ctx context.Context, id string, usage bool) (model.Volume, error) {
 	vl, err := h.client.VolumeInspect(ctx, id)
 	if err != nil {
 		if client.IsErrNotFound(err) {
@@ -55,19 +54,26 @@ func (h *Handler) VolumeInfo(ctx context.Context, id string) (model.Volume, erro
 		}
 		return model.Volume{}, model.NewInternalError(err)
 	}
-	vol.Name = vl.Name
-	vol.Labels = vl.Labels
-	if ti, err := hdl_util.ParseTimestamp(vl.CreatedAt); err != nil {
-		util.Logger.Errorf("parsing created timestamp for volume '%s' failed: %s", vl.Name, err)
-	} else {
-		vol.Created = ti
+	var vlUsage map[string]*volume.UsageData
+	if usage {
+		if vlUsage, err = h.getVolumeUsage(ctx); err != nil {
+			return model.Volume{}, err
+		}
 	}
-	return vol, nil
+	return newVolume(vl, vlUsage), nil
 }
 
 func (h *Handler) VolumeCreate(ctx context.Context, vol model.Volume) (string, error) {
-	res, err := h.client.VolumeCreate(ctx, volume.CreateOptions{Name: vol.Name, Labels: vol.Labels})
+	res, err := h.client.VolumeCreate(ctx, volume.CreateOptions{
+		Name:       vol.Name,
+		Driver:     vol.Driver,
+		DriverOpts: vol.DriverOpts,
+		Labels:     vol.Labels,
+	})
 	if err != nil {
+		if errdefs.IsInvalidParameter(err) || errdefs.IsConflict(err) {
+			return "", model.NewInvalidInputError(err)
+		}
 		return "", model.NewInternalError(err)
 	}
 	return res.Name, nil
@@ -82,3 +88,41 @@ func (h *Handler) VolumeRemove(ctx context.Context, id string, force bool) error
 	}
 	return nil
 }
+
+// getVolumeUsage retrieves usage data of all volumes from the engine's disk usage API.
+func (h *Handler) getVolumeUsage(ctx context.Context) (map[string]*volume.UsageData, error) {
+	du, err := h.client.DiskUsage(ctx, types.DiskUsageOptions{Types: []types.DiskUsageObject{types.VolumeObject}})
+	if err != nil {
+		return nil, model.NewInternalError(fmt.Errorf("retrieving volume usage failed: %s", err))
+	}
+	usage := make(map[string]*volume.UsageData)
+	for _, vl := range du.Volumes {
+		if vl != nil && vl.UsageData != nil {
+			usage[vl.Name] = vl.UsageData
+		}
+	}
+	return usage, nil
+}
+
+func newVolume(vl volume.Volume, usage map[string]*volume.UsageData) model.Volume {
+	vol := model.Volume{
+		Name:       vl.Name,
+		Labels:     vl.Labels,
+		Driver:     vl.Driver,
+		DriverOpts: hdl_util.ParseVolumeDriverOptions(vl.Options),
+		Mountpoint: vl.Mountpoint,
+		Scope:      vl.Scope,
+	}
+	if ti, err := hdl_util.ParseTimestamp(vl.CreatedAt); err != nil {
+		util.Logger.Errorf("parsing created timestamp for volume '%s' failed: %s", vl.Name, err)
+	} else {
+		vol.Created = ti
+	}
+	if u, ok := usage[vl.Name]; ok {
+		vol.Usage = &model.VolumeUsage{
+			Size:     u.Size,
+			RefCount: u.RefCount,
+		}
+	}
+	return vol
+}
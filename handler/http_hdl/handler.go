@@ -27,14 +27,14 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func New(a lib.Api, staticHeader map[string]string) (*gin.Engine, error) {
+func New(a lib.Api, staticHeader map[string]string, imgImportMaxSize int64) (*gin.Engine, error) {
 	gin.SetMode(gin.ReleaseMode)
 	httpHandler := gin.New()
 	httpHandler.Use(gin_mw.StaticHeaderHandler(staticHeader), requestid.New(requestid.WithCustomHeaderStrKey(lib_model.HeaderRequestID)), gin_mw.LoggerHandler(util.Logger, nil, func(gc *gin.Context) string {
 		return requestid.Get(gc)
 	}), gin_mw.ErrorHandler(util.GetStatusCode, ", "), gin.Recovery())
 	httpHandler.UseRawPath = true
-	err := standard.SetRoutes(httpHandler, a)
+	err := standard.SetRoutes(httpHandler, a, imgImportMaxSize)
 	if err != nil {
 		return nil, err
 	}
@@ -17,12 +17,13 @@
 package shared
 
 import (
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/http_hdl/util"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"github.com/gin-gonic/gin"
-	"io"
 	"net/http"
 	"path"
+	"strings"
 	"time"
 )
 
@@ -30,17 +31,23 @@ type containerLogQuery struct {
 	MaxLines int    `form:"max_lines"`
 	Since    string `form:"since"`
 	Until    string `form:"until"`
+	Follow   bool   `form:"follow"`
+	Stream   string `form:"stream"`
+	Format   string `form:"format"`
 }
 
 // getContainerLogH godoc
 // @Summary Get container log
 // @Description Get a container's log.
 // @Tags Containers
-// @Produce	plain
+// @Produce	plain,application/x-ndjson
 // @Param id path string true "container ID"
 // @Param max_lines query integer false "max num of lines"
 // @Param since query string false "RFC3339Nano timestamp"
 // @Param until query string false "RFC3339Nano timestamp"
+// @Param follow query bool false "keep streaming new log lines until the container stops"
+// @Param stream query string false "only return lines of the given stream" Enums(stdout, stderr)
+// @Param format query string false "output format, 'ndjson' returns one model.LogEntry object per line (alternatively set the Accept header to application/x-ndjson)" Enums(text, ndjson)
 // @Success	200 {string} string "log"
 // @Failure	400 {string} string "error message"
 // @Failure	404 {string} string "error message"
@@ -53,7 +60,15 @@ func getContainerLogH(a lib.Api) (string, string, gin.HandlerFunc) {
 			_ = c.Error(model.NewInvalidInputError(err))
 			return
 		}
-		logOptions := model.LogFilter{MaxLines: query.MaxLines}
+		logOptions := model.LogFilter{
+			MaxLines: query.MaxLines,
+			Follow:   query.Follow,
+			Stream:   query.Stream,
+			Format:   query.Format,
+		}
+		if logOptions.Format == "" && strings.Contains(c.GetHeader("Accept"), model.MIMEApplicationNDJSON) {
+			logOptions.Format = model.NDJSONLogFormat
+		}
 		if query.Since != "" {
 			t, err := time.Parse(time.RFC3339Nano, query.Since)
 			if err != nil {
@@ -76,33 +91,10 @@ func getContainerLogH(a lib.Api) (string, string, gin.HandlerFunc) {
 			return
 		}
 		defer rc.Close()
-		c.Status(http.StatusOK)
-		c.Header("Transfer-Encoding", "chunked")
-		c.Header("Content-Type", gin.MIMEPlain)
-		for {
-			var b = make([]byte, 204800)
-			n, rErr := rc.Read(b)
-			if rErr != nil {
-				if rErr == io.EOF {
-					if n > 0 {
-						_, wErr := c.Writer.Write(b[:n])
-						if wErr != nil {
-							_ = c.Error(model.NewInternalError(wErr))
-							return
-						}
-						c.Writer.Flush()
-					}
-					break
-				}
-				_ = c.Error(model.NewInternalError(rErr))
-				return
-			}
-			_, wErr := c.Writer.Write(b)
-			if wErr != nil {
-				_ = c.Error(model.NewInternalError(wErr))
-				return
-			}
-			c.Writer.Flush()
+		if logOptions.Format == model.NDJSONLogFormat {
+			util.WriteStream(c, rc, model.MIMEApplicationNDJSON)
+		} else {
+			util.WriteStream(c, rc, gin.MIMEPlain)
 		}
 	}
 }
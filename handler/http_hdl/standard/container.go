@@ -38,6 +38,14 @@ type deleteContainerQuery struct {
 	Force bool `form:"force"`
 }
 
+type containerNetDisconnectQuery struct {
+	Force bool `form:"force"`
+}
+
+type containerStatsQuery struct {
+	Stream bool `form:"stream"`
+}
+
 // getContainersH godoc
 // @Summary Get containers
 // @Description List all containers.
@@ -132,6 +140,33 @@ func deleteContainerH(a lib.Api) (string, string, gin.HandlerFunc) {
 	}
 }
 
+// patchContainerH godoc
+// @Summary Update container
+// @Description Update the restart strategy and resource limits of a container. Omitted or zero values are not changed.
+// @Tags Containers
+// @Accept json
+// @Param id path string true "container ID"
+// @Param data body model.ContainerUpdate true "update data"
+// @Success	200
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /containers/{id} [patch]
+func patchContainerH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPatch, path.Join(model.ContainersPath, ":id"), func(gc *gin.Context) {
+		update := model.ContainerUpdate{}
+		if err := gc.ShouldBindJSON(&update); err != nil {
+			_ = gc.Error(model.NewInvalidInputError(err))
+			return
+		}
+		if err := a.UpdateContainer(gc.Request.Context(), gc.Param("id"), update); err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
 // getContainerH godoc
 // @Summary Get container
 // @Description Get a container.
@@ -215,9 +250,107 @@ func patchContainerRestartH(a lib.Api) (string, string, gin.HandlerFunc) {
 	}
 }
 
+// patchContainerPauseH godoc
+// @Summary Pause container
+// @Description Suspend all processes of a running container.
+// @Tags Containers
+// @Param id path string true "container ID"
+// @Success	200
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /containers/{id}/pause [patch]
+func patchContainerPauseH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPatch, path.Join(model.ContainersPath, ":id", model.ContainerPausePath), func(gc *gin.Context) {
+		err := a.PauseContainer(gc.Request.Context(), gc.Param("id"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
+// patchContainerUnpauseH godoc
+// @Summary Unpause container
+// @Description Resume all processes of a paused container.
+// @Tags Containers
+// @Param id path string true "container ID"
+// @Success	200
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /containers/{id}/unpause [patch]
+func patchContainerUnpauseH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPatch, path.Join(model.ContainersPath, ":id", model.ContainerUnpausePath), func(gc *gin.Context) {
+		err := a.UnpauseContainer(gc.Request.Context(), gc.Param("id"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
+// postContainerNetH godoc
+// @Summary Connect network
+// @Description Connect a container to a network. The network is identified by name or ID.
+// @Tags Containers
+// @Accept json
+// @Param id path string true "container ID"
+// @Param data body model.ContainerNet true "network data"
+// @Success	200
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /containers/{id}/networks [post]
+func postContainerNetH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, path.Join(model.ContainersPath, ":id", model.ContainerNetsPath), func(gc *gin.Context) {
+		net := model.ContainerNet{}
+		if err := gc.ShouldBindJSON(&net); err != nil {
+			_ = gc.Error(model.NewInvalidInputError(err))
+			return
+		}
+		err := a.ConnectContainerNetwork(gc.Request.Context(), gc.Param("id"), net)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
+// deleteContainerNetH godoc
+// @Summary Disconnect network
+// @Description Disconnect a container from a network.
+// @Tags Containers
+// @Param id path string true "container ID"
+// @Param net path string true "network name or ID"
+// @Param force query bool false "force disconnect"
+// @Success	200
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /containers/{id}/networks/{net} [delete]
+func deleteContainerNetH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodDelete, path.Join(model.ContainersPath, ":id", model.ContainerNetsPath, ":net"), func(gc *gin.Context) {
+		query := containerNetDisconnectQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(model.NewInvalidInputError(err))
+			return
+		}
+		err := a.DisconnectContainerNetwork(gc.Request.Context(), gc.Param("id"), gc.Param("net"), query.Force)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
 // patchContainerExecH godoc
 // @Summary Execute command
-// @Description Execute a command in a running container.
+// @Description Execute a command in a running container. The job result contains the exit code and output of the command.
 // @Tags Containers
 // @Accept json
 // @Produce	plain
@@ -243,3 +376,41 @@ func patchContainerExecH(a lib.Api) (string, string, gin.HandlerFunc) {
 		gc.String(http.StatusOK, jID)
 	}
 }
+
+// getContainerStatsH godoc
+// @Summary Get container stats
+// @Description Get resource usage statistics of a container. If stream is set, statistics are continuously returned as newline delimited JSON.
+// @Tags Containers
+// @Produce	json,application/x-ndjson
+// @Param id path string true "container ID"
+// @Param stream query bool false "stream statistics"
+// @Success	200 {object} model.ContainerStats "container stats"
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /containers/{id}/stats [get]
+func getContainerStatsH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, path.Join(model.ContainersPath, ":id", model.ContainerStatsPath), func(gc *gin.Context) {
+		query := containerStatsQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(model.NewInvalidInputError(err))
+			return
+		}
+		if query.Stream {
+			rc, err := a.GetContainerStatsStream(gc.Request.Context(), gc.Param("id"))
+			if err != nil {
+				_ = gc.Error(err)
+				return
+			}
+			defer rc.Close()
+			util.WriteStream(gc, rc, model.MIMEApplicationNDJSON)
+			return
+		}
+		stats, err := a.GetContainerStats(gc.Request.Context(), gc.Param("id"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, stats)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package standard
+
+import (
+	"fmt"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/http_hdl/util"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"time"
+)
+
+const eventsHeartbeatInterval = 15 * time.Second
+
+type eventsQuery struct {
+	Types  string `form:"types"`
+	Ids    string `form:"ids"`
+	Labels string `form:"labels"`
+}
+
+// getEventsH godoc
+// @Summary Get events
+// @Description Subscribe to container engine events. Events are sent as server-sent events until the client disconnects. Errors occurring after the stream has been opened are sent as 'error' events and end the stream. Heartbeat comments are sent periodically.
+// @Tags Events
+// @Produce	text/event-stream
+// @Param types query string false "filter by resource types (e.g.: container,image,volume,network)"
+// @Param ids query string false "filter by resource IDs or names (e.g.: id1,name2)"
+// @Param labels query string false "filter by label (e.g.: l1=v1,l2=v2,l3)"
+// @Success	200 {object} model.Event "event stream"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /events [get]
+func getEventsH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, model.EventsPath, func(gc *gin.Context) {
+		query := eventsQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(model.NewInvalidInputError(err))
+			return
+		}
+		filter := model.EventFilter{
+			Types:  util.ParseStringSlice(query.Types, ","),
+			Ids:    util.ParseStringSlice(query.Ids, ","),
+			Labels: util.GenLabels(util.ParseStringSlice(query.Labels, ",")),
+		}
+		for _, t := range filter.Types {
+			if _, ok := model.EventTypeMap[t]; !ok {
+				_ = gc.Error(model.NewInvalidInputError(fmt.Errorf("unknown event type '%s'", t)))
+				return
+			}
+		}
+		eventChan, errChan := a.GetEvents(gc.Request.Context(), filter)
+		gc.Header("Cache-Control", "no-cache")
+		gc.Header("Content-Type", "text/event-stream")
+		gc.Status(http.StatusOK)
+		_, _ = io.WriteString(gc.Writer, ": connected\n\n")
+		gc.Writer.Flush()
+		ticker := time.NewTicker(eventsHeartbeatInterval)
+		defer ticker.Stop()
+		gc.Stream(func(w io.Writer) bool {
+			select {
+			case <-gc.Request.Context().Done():
+				return false
+			case <-ticker.C:
+				_, _ = io.WriteString(w, ": heartbeat\n\n")
+				return true
+			case err := <-errChan:
+				if err != nil {
+					writeEventErr(gc, err)
+				}
+				return false
+			case event, ok := <-eventChan:
+				if !ok {
+					select {
+					case err := <-errChan:
+						if err != nil {
+							writeEventErr(gc, err)
+						}
+					default:
+					}
+					return false
+				}
+				gc.SSEvent("message", event)
+				return true
+			}
+		})
+	}
+}
+
+// writeEventErr sends the error as an 'error' event. Headers have already been sent, so the error is only recorded for logging and the error middleware is skipped.
+func writeEventErr(gc *gin.Context, err error) {
+	gc.SSEvent("error", err.Error())
+	_ = gc.Error(err)
+	gc.Abort()
+}
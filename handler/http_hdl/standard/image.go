@@ -17,6 +17,8 @@
 package standard
 
 import (
+	"errors"
+	"fmt"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/http_hdl/util"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
@@ -36,7 +38,7 @@ type imagesQuery struct {
 // @Description List all container images.
 // @Tags Images
 // @Produce	json
-// @Param name query string false "filter by name"
+// @Param name query string false "filter by repository name, may include a tag or digest (e.g. nginx, nginx:latest, nginx@sha256:...)"
 // @Param tag query string false "filter by image tag"
 // @Param labels query string false "filter by labels (e.g. l1=v1,l2=v2,l3)"
 // @Success	200 {array} model.Image "images"
@@ -83,7 +85,7 @@ func postImageH(a lib.Api) (string, string, gin.HandlerFunc) {
 			_ = gc.Error(model.NewInvalidInputError(err))
 			return
 		}
-		jID, err := a.AddImage(gc.Request.Context(), req.Image)
+		jID, err := a.AddImageWithOptions(gc.Request.Context(), req)
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -92,12 +94,40 @@ func postImageH(a lib.Api) (string, string, gin.HandlerFunc) {
 	}
 }
 
+// postImageUpdateCheckH godoc
+// @Summary Check for image update
+// @Description Compare the digest of a local image with the digest provided by the registry without pulling the image. If a platform is provided, the image must be available for it and a local image of a different platform is reported as outdated.
+// @Tags Images
+// @Accept json
+// @Produce	json
+// @Param data body model.ImageRequest true "image data"
+// @Success	200 {object} model.ImageUpdateCheck "update check result"
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /images/update-check [post]
+func postImageUpdateCheckH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, path.Join(model.ImagesPath, model.ImageUpdateCheckPath), func(gc *gin.Context) {
+		req := model.ImageRequest{}
+		if err := gc.ShouldBindJSON(&req); err != nil {
+			_ = gc.Error(model.NewInvalidInputError(err))
+			return
+		}
+		res, err := a.CheckImageUpdate(gc.Request.Context(), req)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
 // getImageH godoc
 // @Summary Get image
 // @Description Get container image info.
 // @Tags Images
 // @Produce	json
-// @Param id path string true "image ID"
+// @Param id path string true "image ID or reference (e.g. nginx:latest, nginx@sha256:...)"
 // @Success	200 {object} model.Image "image data"
 // @Failure	404 {string} string "error message"
 // @Failure	500 {string} string "error message"
@@ -113,6 +143,28 @@ func getImageH(a lib.Api) (string, string, gin.HandlerFunc) {
 	}
 }
 
+// getImageDetailsH godoc
+// @Summary Get image details
+// @Description Get container image info including configuration defaults and layer history.
+// @Tags Images
+// @Produce	json
+// @Param id path string true "image ID or reference (e.g. nginx:latest, nginx@sha256:...)"
+// @Success	200 {object} model.ImageDetails "image details"
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /images/{id}/details [get]
+func getImageDetailsH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, path.Join(model.ImagesPath, ":id", model.ImageDetailsPath), func(gc *gin.Context) {
+		details, err := a.GetImageDetails(gc.Request.Context(), gc.Param("id"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, details)
+	}
+}
+
 // deleteImageH godoc
 // @Summary Delete image
 // @Description Remove a container image.
@@ -131,3 +183,116 @@ func deleteImageH(a lib.Api) (string, string, gin.HandlerFunc) {
 		gc.Status(http.StatusOK)
 	}
 }
+
+type imageTagQuery struct {
+	Repository string `form:"repository"`
+	Tag        string `form:"tag"`
+}
+
+// postImageTagH godoc
+// @Summary Tag image
+// @Description Add a tag to a container image. The tag defaults to 'latest'.
+// @Tags Images
+// @Accept json
+// @Param id path string true "image ID"
+// @Param data body model.ImageTagRequest true "tag data"
+// @Success	200
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /images/{id}/tags [post]
+func postImageTagH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, path.Join(model.ImagesPath, ":id", model.ImageTagsPath), func(gc *gin.Context) {
+		req := model.ImageTagRequest{}
+		if err := gc.ShouldBindJSON(&req); err != nil {
+			_ = gc.Error(model.NewInvalidInputError(err))
+			return
+		}
+		if err := a.TagImage(gc.Request.Context(), gc.Param("id"), req.Repository, req.Tag); err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
+// deleteImageTagH godoc
+// @Summary Untag image
+// @Description Remove a tag from a container image. The last tag of an image can't be removed.
+// @Tags Images
+// @Param id path string true "image ID"
+// @Param repository query string true "image repository"
+// @Param tag query string false "image tag (default 'latest')"
+// @Success	200
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /images/{id}/tags [delete]
+func deleteImageTagH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodDelete, path.Join(model.ImagesPath, ":id", model.ImageTagsPath), func(gc *gin.Context) {
+		query := imageTagQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(model.NewInvalidInputError(err))
+			return
+		}
+		if err := a.UntagImage(gc.Request.Context(), gc.Param("id"), query.Repository, query.Tag); err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
+// postImageImportH godoc
+// @Summary Import image
+// @Description Load container images from a tar archive as created by 'docker save'. The job result contains the loaded images. Archives exceeding the configured size limit are rejected.
+// @Tags Images
+// @Accept application/x-tar
+// @Produce	plain
+// @Param data body string true "tar archive"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /images/import [post]
+func postImageImportH(maxSize int64) func(a lib.Api) (string, string, gin.HandlerFunc) {
+	return func(a lib.Api) (string, string, gin.HandlerFunc) {
+		return http.MethodPost, path.Join(model.ImagesPath, model.ImageImportPath), func(gc *gin.Context) {
+			body := gc.Request.Body
+			if maxSize > 0 {
+				body = http.MaxBytesReader(gc.Writer, body, maxSize)
+			}
+			jID, err := a.ImportImage(gc.Request.Context(), body)
+			if err != nil {
+				var mbErr *http.MaxBytesError
+				if errors.As(err, &mbErr) {
+					err = model.NewInvalidInputError(fmt.Errorf("archive exceeds size limit of %d bytes", mbErr.Limit))
+				}
+				_ = gc.Error(err)
+				return
+			}
+			gc.String(http.StatusOK, jID)
+		}
+	}
+}
+
+// getImageExportH godoc
+// @Summary Export image
+// @Description Save a container image to a tar archive.
+// @Tags Images
+// @Produce	application/x-tar
+// @Param id path string true "image ID"
+// @Success	200 {file} file "tar archive"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /images/{id}/export [get]
+func getImageExportH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, path.Join(model.ImagesPath, ":id", model.ImageExportPath), func(gc *gin.Context) {
+		rc, err := a.ExportImage(gc.Request.Context(), gc.Param("id"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		defer rc.Close()
+		util.WriteStream(gc, rc, model.MIMEApplicationTar)
+	}
+}
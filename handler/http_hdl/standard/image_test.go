@@ -0,0 +1,78 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package standard
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gin_mw "github.com/SENERGY-Platform/gin-middleware"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/util"
+	"github.com/gin-gonic/gin"
+)
+
+type testImportApi struct {
+	lib.Api
+	size int64
+}
+
+func (a *testImportApi) ImportImage(_ context.Context, r io.Reader) (string, error) {
+	n, err := io.Copy(io.Discard, r)
+	if err != nil {
+		return "", model.NewInternalError(err)
+	}
+	a.size = n
+	return "job", nil
+}
+
+func TestPostImageImportH(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name       string
+		limit      int64
+		body       string
+		wantStatus int
+	}{
+		{name: "no limit", limit: 0, body: "0123456789", wantStatus: http.StatusOK},
+		{name: "below limit", limit: 20, body: "0123456789", wantStatus: http.StatusOK},
+		{name: "at limit", limit: 10, body: "0123456789", wantStatus: http.StatusOK},
+		{name: "exceeds limit", limit: 5, body: "0123456789", wantStatus: http.StatusBadRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a := &testImportApi{}
+			e := gin.New()
+			e.Use(gin_mw.ErrorHandler(util.GetStatusCode, ", "))
+			e.Handle(postImageImportH(tc.limit)(a))
+			req := httptest.NewRequest(http.MethodPost, "/"+model.ImagesPath+"/"+model.ImageImportPath, strings.NewReader(tc.body))
+			w := httptest.NewRecorder()
+			e.ServeHTTP(w, req)
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.wantStatus, w.Code, w.Body.String())
+			}
+			if tc.wantStatus == http.StatusOK && a.size != int64(len(tc.body)) {
+				t.Errorf("expected %d bytes, got %d", len(tc.body), a.size)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@
 package standard
 
 import (
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/http_hdl/util"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	"github.com/gin-gonic/gin"
@@ -24,17 +25,45 @@ import (
 	"path"
 )
 
+type networksQuery struct {
+	Ids           string `form:"ids"`
+	Names         string `form:"names"`
+	Type          string `form:"type"`
+	Labels        string `form:"labels"`
+	SupportedOnly bool   `form:"supported_only"`
+}
+
 // getNetworksH godoc
 // @Summary Get networks
 // @Description List all container networks.
 // @Tags Networks
 // @Produce	json
+// @Param ids query string false "filter by IDs (e.g.: id1,id2)"
+// @Param names query string false "filter by names (e.g.: n1,n2)"
+// @Param type query string false "filter by network type"
+// @Param labels query string false "filter by label (e.g.: l1=v1,l2=v2,l3)"
+// @Param supported_only query bool false "exclude networks with unsupported drivers"
 // @Success	200 {array} model.Network "networks"
+// @Failure	400 {string} string "error message"
 // @Failure	500 {string} string "error message"
 // @Router /networks [get]
 func getNetworksH(a lib.Api) (string, string, gin.HandlerFunc) {
 	return http.MethodGet, model.NetworksPath, func(gc *gin.Context) {
-		networks, err := a.GetNetworks(gc.Request.Context())
+		query := networksQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(model.NewInvalidInputError(err))
+			return
+		}
+		networks, err := a.GetNetworks(
+			gc.Request.Context(),
+			model.NetworkFilter{
+				Ids:           util.ParseStringSlice(query.Ids, ","),
+				Names:         util.ParseStringSlice(query.Names, ","),
+				Type:          query.Type,
+				Labels:        util.GenLabels(util.ParseStringSlice(query.Labels, ",")),
+				SupportedOnly: query.SupportedOnly,
+			},
+		)
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -93,10 +122,11 @@ func getNetworkH(a lib.Api) (string, string, gin.HandlerFunc) {
 
 // deleteNetworkH godoc
 // @Summary Delete network
-// @Description Remove a container network.
+// @Description Remove a container network. Predefined networks (bridge, host, none, ingress, docker_gwbridge) can't be removed.
 // @Tags Networks
 // @Param id path string true "network ID"
 // @Success	200
+// @Failure	400 {string} string "error message"
 // @Failure	404 {string} string "error message"
 // @Failure	500 {string} string "error message"
 // @Router /networks/{id} [delete]
@@ -0,0 +1,139 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package standard
+
+import (
+	"context"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/handler/http_hdl/util"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"path"
+	"time"
+)
+
+type pruneQuery struct {
+	All       bool          `form:"all"`
+	Labels    string        `form:"labels"`
+	OlderThan time.Duration `form:"older_than"`
+}
+
+// postContainersPruneH godoc
+// @Summary Prune containers
+// @Description Remove stopped containers. The job result contains the removed container IDs and reclaimed space.
+// @Tags Containers
+// @Produce	plain
+// @Param labels query string false "filter by labels (e.g. l1=v1,l2=v2,l3)"
+// @Param older_than query string false "only remove containers created before the given duration (e.g. 24h)"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /containers/prune [post]
+func postContainersPruneH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, path.Join(model.ContainersPath, model.PrunePath), func(gc *gin.Context) {
+		handlePrune(gc, a.PruneContainers)
+	}
+}
+
+// postImagesPruneH godoc
+// @Summary Prune images
+// @Description Remove dangling images or, if all is set, all unused images. The job result contains the removed image IDs and reclaimed space.
+// @Tags Images
+// @Produce	plain
+// @Param all query bool false "remove all unused images"
+// @Param labels query string false "filter by labels (e.g. l1=v1,l2=v2,l3)"
+// @Param older_than query string false "only remove images created before the given duration (e.g. 24h)"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /images/prune [post]
+func postImagesPruneH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, path.Join(model.ImagesPath, model.PrunePath), func(gc *gin.Context) {
+		handlePrune(gc, a.PruneImages)
+	}
+}
+
+// postNetworksPruneH godoc
+// @Summary Prune networks
+// @Description Remove unused networks. The job result contains the removed network IDs.
+// @Tags Networks
+// @Produce	plain
+// @Param labels query string false "filter by labels (e.g. l1=v1,l2=v2,l3)"
+// @Param older_than query string false "only remove networks created before the given duration (e.g. 24h)"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /networks/prune [post]
+func postNetworksPruneH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, path.Join(model.NetworksPath, model.PrunePath), func(gc *gin.Context) {
+		handlePrune(gc, a.PruneNetworks)
+	}
+}
+
+// postVolumesPruneH godoc
+// @Summary Prune volumes
+// @Description Remove unused anonymous volumes or, if all is set, all unused volumes. The job result contains the removed volume names and reclaimed space.
+// @Tags Volumes
+// @Produce	plain
+// @Param all query bool false "include named volumes"
+// @Param labels query string false "filter by labels (e.g. l1=v1,l2=v2,l3)"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /volumes/prune [post]
+func postVolumesPruneH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, path.Join(model.VolumesPath, model.PrunePath), func(gc *gin.Context) {
+		handlePrune(gc, a.PruneVolumes)
+	}
+}
+
+// postBuildCachePruneH godoc
+// @Summary Prune build cache
+// @Description Remove dangling build cache or, if all is set, the whole unused build cache. The job result contains the removed cache IDs and reclaimed space.
+// @Tags Build Cache
+// @Produce	plain
+// @Param all query bool false "remove all unused build cache"
+// @Param older_than query string false "only remove build cache older than the given duration (e.g. 24h)"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /build-cache/prune [post]
+func postBuildCachePruneH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, path.Join(model.BuildCachePath, model.PrunePath), func(gc *gin.Context) {
+		handlePrune(gc, a.PruneBuildCache)
+	}
+}
+
+func handlePrune(gc *gin.Context, pFunc func(ctx context.Context, filter model.PruneFilter) (string, error)) {
+	query := pruneQuery{}
+	if err := gc.ShouldBindQuery(&query); err != nil {
+		_ = gc.Error(model.NewInvalidInputError(err))
+		return
+	}
+	filter := model.PruneFilter{
+		All:       query.All,
+		Labels:    util.GenLabels(util.ParseStringSlice(query.Labels, ",")),
+		OlderThan: query.OlderThan,
+	}
+	jID, err := pFunc(gc.Request.Context(), filter)
+	if err != nil {
+		_ = gc.Error(err)
+		return
+	}
+	gc.String(http.StatusOK, jID)
+}
@@ -32,14 +32,25 @@ var routes = gin_mw.Routes[lib.Api]{
 	postContainerH,
 	deleteContainerH,
 	getContainerH,
+	patchContainerH,
 	patchContainerStartH,
 	patchContainerStopH,
 	patchContainerRestartH,
+	patchContainerPauseH,
+	patchContainerUnpauseH,
+	postContainerNetH,
+	deleteContainerNetH,
 	patchContainerExecH,
+	getContainerStatsH,
 	getImagesH,
 	postImageH,
+	postImageUpdateCheckH,
 	getImageH,
+	getImageDetailsH,
 	deleteImageH,
+	getImageExportH,
+	postImageTagH,
+	deleteImageTagH,
 	getNetworksH,
 	postNetworkH,
 	getNetworkH,
@@ -48,6 +59,12 @@ var routes = gin_mw.Routes[lib.Api]{
 	postVolumeH,
 	getVolumeH,
 	deleteVolumeH,
+	getEventsH,
+	postContainersPruneH,
+	postImagesPruneH,
+	postNetworksPruneH,
+	postVolumesPruneH,
+	postBuildCachePruneH,
 }
 
 // SetRoutes
@@ -57,8 +74,9 @@ var routes = gin_mw.Routes[lib.Api]{
 // @license.name Apache-2.0
 // @license.url http://www.apache.org/licenses/LICENSE-2.0.html
 // @BasePath /
-func SetRoutes(e *gin.Engine, a lib.Api) error {
+func SetRoutes(e *gin.Engine, a lib.Api, imgImportMaxSize int64) error {
 	rg := e.Group("")
+	routes = append(routes, postImageImportH(imgImportMaxSize))
 	routes = append(routes, shared.Routes...)
 	err := routes.Set(a, rg, util.Logger)
 	if err != nil {
@@ -29,6 +29,11 @@ import (
 type volumesQuery struct {
 	Names  string `form:"names"`
 	Labels string `form:"labels"`
+	Usage  bool   `form:"usage"`
+}
+
+type volumeQuery struct {
+	Usage bool `form:"usage"`
 }
 
 type deleteVolumeQuery struct {
@@ -41,6 +46,7 @@ type deleteVolumeQuery struct {
 // @Tags Volumes
 // @Produce	json
 // @Param labels query string false "filter by label (e.g.: l1=v1,l2=v2,l3)"
+// @Param usage query bool false "include disk usage, requires the engine to scan all volumes"
 // @Success	200 {array} model.Volume "volumes"
 // @Failure	400 {string} string "error message"
 // @Failure	500 {string} string "error message"
@@ -57,6 +63,7 @@ func getVolumesH(a lib.Api) (string, string, gin.HandlerFunc) {
 			model.VolumeFilter{
 				Names:  util.ParseStringSlice(query.Names, ","),
 				Labels: util.GenLabels(util.ParseStringSlice(query.Labels, ",")),
+				Usage:  query.Usage,
 			},
 		)
 		if err != nil {
@@ -96,17 +103,30 @@ func postVolumeH(a lib.Api) (string, string, gin.HandlerFunc) {
 
 // getVolumeH godoc
 // @Summary Get volume
-// @Description Get storage volume info.
+// @Description Get storage volume info. Disk usage is only included if requested.
 // @Tags Volumes
 // @Produce	json
 // @Param id path string true "volume ID"
+// @Param usage query bool false "include disk usage, requires the engine to scan all volumes"
 // @Success	200 {object} model.Volume "volume data"
+// @Failure	400 {string} string "error message"
 // @Failure	404 {string} string "error message"
 // @Failure	500 {string} string "error message"
 // @Router /volumes/{id} [get]
 func getVolumeH(a lib.Api) (string, string, gin.HandlerFunc) {
 	return http.MethodGet, path.Join(model.VolumesPath, ":id"), func(gc *gin.Context) {
-		volume, err := a.GetVolume(gc.Request.Context(), gc.Param("id"))
+		query := volumeQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(model.NewInvalidInputError(err))
+			return
+		}
+		var volume model.Volume
+		var err error
+		if query.Usage {
+			volume, err = a.GetVolumeWithUsage(gc.Request.Context(), gc.Param("id"))
+		} else {
+			volume, err = a.GetVolume(gc.Request.Context(), gc.Param("id"))
+		}
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -194,7 +194,8 @@ const docTemplaterestricted = `{
             "get": {
                 "description": "Get a container's log.",
                 "produces": [
-                    "text/plain"
+                    "text/plain",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "Containers"
@@ -225,6 +226,32 @@ const docTemplaterestricted = `{
                         "description": "RFC3339Nano timestamp",
                         "name": "until",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "keep streaming new log lines until the container stops",
+                        "name": "follow",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "stdout",
+                            "stderr"
+                        ],
+                        "type": "string",
+                        "description": "only return lines of the given stream",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "text",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "output format, 'ndjson' returns one model.LogEntry object per line (alternatively set the Accept header to application/x-ndjson)",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -332,16 +359,24 @@ const docTemplaterestricted = `{
         "time.Duration": {
             "type": "integer",
             "enum": [
+                -9223372036854775808,
+                9223372036854775807,
                 1,
                 1000,
                 1000000,
-                1000000000
+                1000000000,
+                60000000000,
+                3600000000000
             ],
             "x-enum-varnames": [
+                "minDuration",
+                "maxDuration",
                 "Nanosecond",
                 "Microsecond",
                 "Millisecond",
-                "Second"
+                "Second",
+                "Minute",
+                "Hour"
             ]
         }
     }
@@ -19,6 +19,52 @@ const docTemplatestandard = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/build-cache/prune": {
+            "post": {
+                "description": "Remove dangling build cache or, if all is set, the whole unused build cache. The job result contains the removed cache IDs and reclaimed space.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Build Cache"
+                ],
+                "summary": "Prune build cache",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "remove all unused build cache",
+                        "name": "all",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only remove build cache older than the given duration (e.g. 24h)",
+                        "name": "older_than",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/containers": {
             "get": {
                 "description": "List all containers.",
@@ -118,6 +164,52 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "/containers/prune": {
+            "post": {
+                "description": "Remove stopped containers. The job result contains the removed container IDs and reclaimed space.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Containers"
+                ],
+                "summary": "Prune containers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filter by labels (e.g. l1=v1,l2=v2,l3)",
+                        "name": "labels",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only remove containers created before the given duration (e.g. 24h)",
+                        "name": "older_than",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/containers/{id}": {
             "get": {
                 "description": "Get a container.",
@@ -202,21 +294,16 @@ const docTemplatestandard = `{
                         }
                     }
                 }
-            }
-        },
-        "/containers/{id}/exec": {
+            },
             "patch": {
-                "description": "Execute a command in a running container.",
+                "description": "Update the restart strategy and resource limits of a container. Omitted or zero values are not changed.",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "text/plain"
-                ],
                 "tags": [
                     "Containers"
                 ],
-                "summary": "Execute command",
+                "summary": "Update container",
                 "parameters": [
                     {
                         "type": "string",
@@ -226,21 +313,18 @@ const docTemplatestandard = `{
                         "required": true
                     },
                     {
-                        "description": "command data",
-                        "name": "cmd",
+                        "description": "update data",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.ExecConfig"
+                            "$ref": "#/definitions/model.ContainerUpdate"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "job ID",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "error message",
@@ -263,16 +347,19 @@ const docTemplatestandard = `{
                 }
             }
         },
-        "/containers/{id}/restart": {
+        "/containers/{id}/exec": {
             "patch": {
-                "description": "Restart a container.",
+                "description": "Execute a command in a running container. The job result contains the exit code and output of the command.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "text/plain"
                 ],
                 "tags": [
                     "Containers"
                 ],
-                "summary": "Restart container",
+                "summary": "Execute command",
                 "parameters": [
                     {
                         "type": "string",
@@ -280,11 +367,26 @@ const docTemplatestandard = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "command data",
+                        "name": "cmd",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ExecConfig"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": " job ID",
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
                         "schema": {
                             "type": "string"
                         }
@@ -304,13 +406,16 @@ const docTemplatestandard = `{
                 }
             }
         },
-        "/containers/{id}/start": {
-            "patch": {
-                "description": "Start a container.",
+        "/containers/{id}/networks": {
+            "post": {
+                "description": "Connect a container to a network. The network is identified by name or ID.",
+                "consumes": [
+                    "application/json"
+                ],
                 "tags": [
                     "Containers"
                 ],
-                "summary": "Start container",
+                "summary": "Connect network",
                 "parameters": [
                     {
                         "type": "string",
@@ -318,12 +423,27 @@ const docTemplatestandard = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "network data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ContainerNet"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK"
                     },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "404": {
                         "description": "error message",
                         "schema": {
@@ -339,16 +459,13 @@ const docTemplatestandard = `{
                 }
             }
         },
-        "/containers/{id}/stop": {
-            "patch": {
-                "description": "Stop a container.",
-                "produces": [
-                    "text/plain"
-                ],
+        "/containers/{id}/networks/{net}": {
+            "delete": {
+                "description": "Disconnect a container from a network.",
                 "tags": [
                     "Containers"
                 ],
-                "summary": "Stop container",
+                "summary": "Disconnect network",
                 "parameters": [
                     {
                         "type": "string",
@@ -356,11 +473,27 @@ const docTemplatestandard = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "network name or ID",
+                        "name": "net",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "force disconnect",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "job ID",
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "error message",
                         "schema": {
                             "type": "string"
                         }
@@ -380,47 +513,33 @@ const docTemplatestandard = `{
                 }
             }
         },
-        "/images": {
-            "get": {
-                "description": "List all container images.",
-                "produces": [
-                    "application/json"
-                ],
+        "/containers/{id}/pause": {
+            "patch": {
+                "description": "Suspend all processes of a running container.",
                 "tags": [
-                    "Images"
+                    "Containers"
                 ],
-                "summary": "Get images",
+                "summary": "Pause container",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "filter by name",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter by image tag",
-                        "name": "tag",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "filter by labels (e.g. l1=v1,l2=v2,l3)",
-                        "name": "labels",
-                        "in": "query"
+                        "description": "container ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "images",
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "error message",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.Image"
-                            }
+                            "type": "string"
                         }
                     },
-                    "400": {
+                    "404": {
                         "description": "error message",
                         "schema": {
                             "type": "string"
@@ -433,40 +552,30 @@ const docTemplatestandard = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Download a container image.",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/containers/{id}/restart": {
+            "patch": {
+                "description": "Restart a container.",
                 "produces": [
-                    "application/json",
                     "text/plain"
                 ],
                 "tags": [
-                    "Images"
+                    "Containers"
                 ],
-                "summary": "Add image",
+                "summary": "Restart container",
                 "parameters": [
                     {
-                        "description": "image data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.ImageRequest"
-                        }
+                        "type": "string",
+                        "description": "container ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "job ID",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "400": {
-                        "description": "error message",
+                        "description": " job ID",
                         "schema": {
                             "type": "string"
                         }
@@ -486,20 +595,499 @@ const docTemplatestandard = `{
                 }
             }
         },
-        "/images/{id}": {
-            "get": {
-                "description": "Get container image info.",
-                "produces": [
-                    "application/json"
-                ],
+        "/containers/{id}/start": {
+            "patch": {
+                "description": "Start a container.",
                 "tags": [
-                    "Images"
+                    "Containers"
                 ],
-                "summary": "Get image",
+                "summary": "Start container",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "image ID",
+                        "description": "container ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/containers/{id}/stats": {
+            "get": {
+                "description": "Get resource usage statistics of a container. If stream is set, statistics are continuously returned as newline delimited JSON.",
+                "produces": [
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "Containers"
+                ],
+                "summary": "Get container stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "container ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "stream statistics",
+                        "name": "stream",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "container stats",
+                        "schema": {
+                            "$ref": "#/definitions/model.ContainerStats"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/containers/{id}/stop": {
+            "patch": {
+                "description": "Stop a container.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Containers"
+                ],
+                "summary": "Stop container",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "container ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/containers/{id}/unpause": {
+            "patch": {
+                "description": "Resume all processes of a paused container.",
+                "tags": [
+                    "Containers"
+                ],
+                "summary": "Unpause container",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "container ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/events": {
+            "get": {
+                "description": "Subscribe to container engine events. Events are sent as server-sent events until the client disconnects. Errors occurring after the stream has been opened are sent as 'error' events and end the stream. Heartbeat comments are sent periodically.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Get events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filter by resource types (e.g.: container,image,volume,network)",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter by resource IDs or names (e.g.: id1,name2)",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter by label (e.g.: l1=v1,l2=v2,l3)",
+                        "name": "labels",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "event stream",
+                        "schema": {
+                            "$ref": "#/definitions/model.Event"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/images": {
+            "get": {
+                "description": "List all container images.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Get images",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filter by repository name, may include a tag or digest (e.g. nginx, nginx:latest, nginx@sha256:...)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter by image tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter by labels (e.g. l1=v1,l2=v2,l3)",
+                        "name": "labels",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "images",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Image"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Download a container image.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Add image",
+                "parameters": [
+                    {
+                        "description": "image data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/import": {
+            "post": {
+                "description": "Load container images from a tar archive as created by 'docker save'. The job result contains the loaded images. Archives exceeding the configured size limit are rejected.",
+                "consumes": [
+                    "application/x-tar"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Import image",
+                "parameters": [
+                    {
+                        "description": "tar archive",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/prune": {
+            "post": {
+                "description": "Remove dangling images or, if all is set, all unused images. The job result contains the removed image IDs and reclaimed space.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Prune images",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "remove all unused images",
+                        "name": "all",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter by labels (e.g. l1=v1,l2=v2,l3)",
+                        "name": "labels",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only remove images created before the given duration (e.g. 24h)",
+                        "name": "older_than",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/update-check": {
+            "post": {
+                "description": "Compare the digest of a local image with the digest provided by the registry without pulling the image. If a platform is provided, the image must be available for it and a local image of a different platform is reported as outdated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Check for image update",
+                "parameters": [
+                    {
+                        "description": "image data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "update check result",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImageUpdateCheck"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/{id}": {
+            "get": {
+                "description": "Get container image info.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Get image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "image ID or reference (e.g. nginx:latest, nginx@sha256:...)",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -560,6 +1148,199 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "/images/{id}/details": {
+            "get": {
+                "description": "Get container image info including configuration defaults and layer history.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Get image details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "image ID or reference (e.g. nginx:latest, nginx@sha256:...)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "image details",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImageDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/{id}/export": {
+            "get": {
+                "description": "Save a container image to a tar archive.",
+                "produces": [
+                    "application/x-tar"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Export image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "image ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "tar archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/{id}/tags": {
+            "post": {
+                "description": "Add a tag to a container image. The tag defaults to 'latest'.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Tag image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "image ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "tag data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImageTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a tag from a container image. The last tag of an image can't be removed.",
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Untag image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "image ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "image repository",
+                        "name": "repository",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "image tag (default 'latest')",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/info": {
             "get": {
                 "description": "Get basic service and runtime information.",
@@ -735,7 +1516,8 @@ const docTemplatestandard = `{
             "get": {
                 "description": "Get a container's log.",
                 "produces": [
-                    "text/plain"
+                    "text/plain",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "Containers"
@@ -766,6 +1548,32 @@ const docTemplatestandard = `{
                         "description": "RFC3339Nano timestamp",
                         "name": "until",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "keep streaming new log lines until the container stops",
+                        "name": "follow",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "stdout",
+                            "stderr"
+                        ],
+                        "type": "string",
+                        "description": "only return lines of the given stream",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "text",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "output format, 'ndjson' returns one model.LogEntry object per line (alternatively set the Accept header to application/x-ndjson)",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -806,6 +1614,38 @@ const docTemplatestandard = `{
                     "Networks"
                 ],
                 "summary": "Get networks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filter by IDs (e.g.: id1,id2)",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter by names (e.g.: n1,n2)",
+                        "name": "names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter by network type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter by label (e.g.: l1=v1,l2=v2,l3)",
+                        "name": "labels",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "exclude networks with unsupported drivers",
+                        "name": "supported_only",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "networks",
@@ -816,6 +1656,12 @@ const docTemplatestandard = `{
                             }
                         }
                     },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "error message",
                         "schema": {
@@ -869,6 +1715,52 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "/networks/prune": {
+            "post": {
+                "description": "Remove unused networks. The job result contains the removed network IDs.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Networks"
+                ],
+                "summary": "Prune networks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filter by labels (e.g. l1=v1,l2=v2,l3)",
+                        "name": "labels",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only remove networks created before the given duration (e.g. 24h)",
+                        "name": "older_than",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/networks/{id}": {
             "get": {
                 "description": "Get a container network.",
@@ -910,7 +1802,7 @@ const docTemplatestandard = `{
                 }
             },
             "delete": {
-                "description": "Remove a container network.",
+                "description": "Remove a container network. Predefined networks (bridge, host, none, ingress, docker_gwbridge) can't be removed.",
                 "tags": [
                     "Networks"
                 ],
@@ -928,6 +1820,12 @@ const docTemplatestandard = `{
                     "200": {
                         "description": "OK"
                     },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "404": {
                         "description": "error message",
                         "schema": {
@@ -959,6 +1857,12 @@ const docTemplatestandard = `{
                         "description": "filter by label (e.g.: l1=v1,l2=v2,l3)",
                         "name": "labels",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include disk usage, requires the engine to scan all volumes",
+                        "name": "usage",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1030,9 +1934,55 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "/volumes/prune": {
+            "post": {
+                "description": "Remove unused anonymous volumes or, if all is set, all unused volumes. The job result contains the removed volume names and reclaimed space.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Volumes"
+                ],
+                "summary": "Prune volumes",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "include named volumes",
+                        "name": "all",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter by labels (e.g. l1=v1,l2=v2,l3)",
+                        "name": "labels",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/volumes/{id}": {
             "get": {
-                "description": "Get storage volume info.",
+                "description": "Get storage volume info. Disk usage is only included if requested.",
                 "produces": [
                     "application/json"
                 ],
@@ -1047,6 +1997,12 @@ const docTemplatestandard = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include disk usage, requires the engine to scan all volumes",
+                        "name": "usage",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1056,6 +2012,12 @@ const docTemplatestandard = `{
                             "$ref": "#/definitions/model.Volume"
                         }
                     },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "404": {
                         "description": "error message",
                         "schema": {
@@ -1190,12 +2152,29 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "model.BlockIOStats": {
+            "type": "object",
+            "properties": {
+                "read_bytes": {
+                    "type": "integer"
+                },
+                "write_bytes": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.Container": {
             "type": "object",
             "properties": {
                 "created": {
                     "type": "string"
                 },
+                "device_cgroup_rules": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "devices": {
                     "type": "array",
                     "items": {
@@ -1247,6 +2226,9 @@ const docTemplatestandard = `{
                         "$ref": "#/definitions/model.Port"
                     }
                 },
+                "resources": {
+                    "$ref": "#/definitions/model.Resources"
+                },
                 "run_config": {
                     "$ref": "#/definitions/model.RunConfig"
                 },
@@ -1295,6 +2277,12 @@ const docTemplatestandard = `{
                         "type": "integer"
                     }
                 },
+                "ipv6_address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "mac_address": {
                     "type": "string"
                 },
@@ -1324,6 +2312,46 @@ const docTemplatestandard = `{
                 "DeadState"
             ]
         },
+        "model.ContainerStats": {
+            "type": "object",
+            "properties": {
+                "block_io": {
+                    "$ref": "#/definitions/model.BlockIOStats"
+                },
+                "cpu_percent": {
+                    "type": "number"
+                },
+                "memory": {
+                    "$ref": "#/definitions/model.MemoryStats"
+                },
+                "networks": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.NetworkStats"
+                    }
+                },
+                "pids": {
+                    "type": "integer"
+                },
+                "time": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ContainerUpdate": {
+            "type": "object",
+            "properties": {
+                "resources": {
+                    "$ref": "#/definitions/model.Resources"
+                },
+                "restart_strategy": {
+                    "$ref": "#/definitions/model.RestartStrategy"
+                },
+                "retries": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.Device": {
             "type": "object",
             "properties": {
@@ -1338,35 +2366,149 @@ const docTemplatestandard = `{
                 }
             }
         },
-        "model.ExecConfig": {
+        "model.Event": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "health": {
+                    "$ref": "#/definitions/model.ContainerHealth"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/model.ContainerState"
+                },
+                "time": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.EventType"
+                }
+            }
+        },
+        "model.EventType": {
+            "type": "string",
+            "enum": [
+                "container",
+                "image",
+                "volume",
+                "network"
+            ],
+            "x-enum-varnames": [
+                "ContainerEvent",
+                "ImageEvent",
+                "VolumeEvent",
+                "NetworkEvent"
+            ]
+        },
+        "model.ExecConfig": {
+            "type": "object",
+            "properties": {
+                "cmd": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "envVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "tty": {
+                    "type": "boolean"
+                },
+                "workDir": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.IPAMConfig": {
+            "type": "object",
+            "properties": {
+                "aux_addresses": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "gateway": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "ip_range": {
+                    "$ref": "#/definitions/model.Subnet"
+                },
+                "subnet": {
+                    "$ref": "#/definitions/model.Subnet"
+                }
+            }
+        },
+        "model.Image": {
             "type": "object",
             "properties": {
-                "cmd": {
+                "arch": {
+                    "type": "string"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "digests": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "envVars": {
+                "id": {
+                    "type": "string"
+                },
+                "labels": {
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     }
                 },
-                "tty": {
-                    "type": "boolean"
+                "size": {
+                    "type": "integer"
                 },
-                "workDir": {
-                    "type": "string"
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "model.Image": {
+        "model.ImageDetails": {
             "type": "object",
             "properties": {
                 "arch": {
                     "type": "string"
                 },
+                "cmd": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "created": {
                     "type": "string"
                 },
@@ -1376,6 +2518,24 @@ const docTemplatestandard = `{
                         "type": "string"
                     }
                 },
+                "entrypoint": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "env_vars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ImageHistoryItem"
+                    }
+                },
                 "id": {
                     "type": "string"
                 },
@@ -1385,6 +2545,56 @@ const docTemplatestandard = `{
                         "type": "string"
                     }
                 },
+                "os": {
+                    "type": "string"
+                },
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Port"
+                    }
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user": {
+                    "type": "string"
+                },
+                "variant": {
+                    "type": "string"
+                },
+                "volumes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "working_dir": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ImageHistoryItem": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
                 "size": {
                     "type": "integer"
                 },
@@ -1397,10 +2607,71 @@ const docTemplatestandard = `{
             }
         },
         "model.ImageRequest": {
+            "type": "object",
+            "properties": {
+                "auth": {
+                    "$ref": "#/definitions/model.RegistryAuth"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ImageTagRequest": {
+            "type": "object",
+            "properties": {
+                "repository": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ImageUpdateCheck": {
             "type": "object",
             "properties": {
                 "image": {
                     "type": "string"
+                },
+                "local_digest": {
+                    "type": "string"
+                },
+                "remote_digest": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.ImageUpdateStatus"
+                }
+            }
+        },
+        "model.ImageUpdateStatus": {
+            "type": "string",
+            "enum": [
+                "up_to_date",
+                "update_available",
+                "not_local"
+            ],
+            "x-enum-varnames": [
+                "ImageUpToDate",
+                "ImageUpdateAvailable",
+                "ImageNotLocal"
+            ]
+        },
+        "model.MemoryStats": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "percent": {
+                    "type": "number"
+                },
+                "usage": {
+                    "type": "integer"
                 }
             }
         },
@@ -1449,6 +2720,21 @@ const docTemplatestandard = `{
         "model.Network": {
             "type": "object",
             "properties": {
+                "attachable": {
+                    "type": "boolean"
+                },
+                "containers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NetworkContainer"
+                    }
+                },
+                "driver": {
+                    "type": "string"
+                },
+                "enable_ipv6": {
+                    "type": "boolean"
+                },
                 "gateway": {
                     "type": "array",
                     "items": {
@@ -1458,9 +2744,30 @@ const docTemplatestandard = `{
                 "id": {
                     "type": "string"
                 },
+                "internal": {
+                    "type": "boolean"
+                },
+                "ipam": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.IPAMConfig"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "mode": {
+                    "$ref": "#/definitions/model.NetworkMode"
+                },
                 "name": {
                     "type": "string"
                 },
+                "parent": {
+                    "type": "string"
+                },
                 "subnet": {
                     "$ref": "#/definitions/model.Subnet"
                 },
@@ -1469,16 +2776,76 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "model.NetworkContainer": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "ip_address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "ipv6_address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "mac_address": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.NetworkMode": {
+            "type": "string",
+            "enum": [
+                "bridge",
+                "vepa",
+                "private",
+                "passthru",
+                "l2",
+                "l3",
+                "l3s"
+            ],
+            "x-enum-varnames": [
+                "MACVlanBridgeMode",
+                "MACVlanVEPAMode",
+                "MACVlanPrivateMode",
+                "MACVlanPassthruMode",
+                "IPVlanL2Mode",
+                "IPVlanL3Mode",
+                "IPVlanL3SMode"
+            ]
+        },
+        "model.NetworkStats": {
+            "type": "object",
+            "properties": {
+                "rx_bytes": {
+                    "type": "integer"
+                },
+                "tx_bytes": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.NetworkType": {
             "type": "string",
             "enum": [
                 "bridge",
                 "macvlan",
+                "ipvlan",
                 "host"
             ],
             "x-enum-varnames": [
                 "BridgeNet",
                 "MACVlanNet",
+                "IPVlanNet",
                 "HostNet"
             ]
         },
@@ -1526,6 +2893,52 @@ const docTemplatestandard = `{
                 "SctpPort"
             ]
         },
+        "model.RegistryAuth": {
+            "type": "object",
+            "properties": {
+                "identity_token": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Resources": {
+            "type": "object",
+            "properties": {
+                "cpu_set": {
+                    "type": "string"
+                },
+                "cpu_shares": {
+                    "type": "integer"
+                },
+                "cpus": {
+                    "type": "number"
+                },
+                "memory": {
+                    "type": "integer"
+                },
+                "memory_reservation": {
+                    "type": "integer"
+                },
+                "memory_swap": {
+                    "type": "integer"
+                },
+                "pids_limit": {
+                    "type": "integer"
+                },
+                "ulimits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Ulimit"
+                    }
+                }
+            }
+        },
         "model.RestartStrategy": {
             "type": "string",
             "enum": [
@@ -1584,44 +2997,79 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "model.Ulimit": {
+            "type": "object",
+            "properties": {
+                "hard": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "soft": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.Volume": {
             "type": "object",
             "properties": {
                 "created": {
                     "type": "string"
                 },
+                "driver": {
+                    "type": "string"
+                },
+                "driver_opts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "labels": {
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     }
                 },
+                "mountpoint": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "usage": {
+                    "$ref": "#/definitions/model.VolumeUsage"
+                }
+            }
+        },
+        "model.VolumeUsage": {
+            "type": "object",
+            "properties": {
+                "ref_count": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
                 }
             }
         },
         "time.Duration": {
             "type": "integer",
             "enum": [
-                -9223372036854775808,
-                9223372036854775807,
                 1,
                 1000,
                 1000000,
-                1000000000,
-                60000000000,
-                3600000000000
+                1000000000
             ],
             "x-enum-varnames": [
-                "minDuration",
-                "maxDuration",
                 "Nanosecond",
                 "Microsecond",
                 "Millisecond",
-                "Second",
-                "Minute",
-                "Hour"
+                "Second"
             ]
         }
     }
@@ -17,6 +17,10 @@
 package util
 
 import (
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
 	"strings"
 )
 
@@ -41,3 +45,29 @@ func ParseStringSlice(s, sep string) []string {
 	}
 	return nil
 }
+
+// WriteStream copies r to the response as chunks until r is exhausted or the client disconnects.
+func WriteStream(gc *gin.Context, r io.Reader, contentType string) {
+	gc.Status(http.StatusOK)
+	gc.Header("Transfer-Encoding", "chunked")
+	gc.Header("Content-Type", contentType)
+	b := make([]byte, 204800)
+	for {
+		n, rErr := r.Read(b)
+		if n > 0 {
+			if _, wErr := gc.Writer.Write(b[:n]); wErr != nil {
+				if gc.Request.Context().Err() == nil {
+					_ = gc.Error(model.NewInternalError(wErr))
+				}
+				return
+			}
+			gc.Writer.Flush()
+		}
+		if rErr != nil {
+			if rErr != io.EOF && gc.Request.Context().Err() == nil {
+				_ = gc.Error(model.NewInternalError(rErr))
+			}
+			return
+		}
+	}
+}
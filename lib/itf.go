@@ -31,21 +31,42 @@ type Api interface {
 	StartContainer(ctx context.Context, id string) error
 	StopContainer(ctx context.Context, id string) (jobId string, err error)
 	RestartContainer(ctx context.Context, id string) (jobId string, err error)
+	PauseContainer(ctx context.Context, id string) error
+	UnpauseContainer(ctx context.Context, id string) error
+	UpdateContainer(ctx context.Context, id string, update model.ContainerUpdate) error
+	ConnectContainerNetwork(ctx context.Context, id string, net model.ContainerNet) error
+	DisconnectContainerNetwork(ctx context.Context, id, netID string, force bool) error
 	RemoveContainer(ctx context.Context, id string, force bool) error
 	GetContainerLog(ctx context.Context, id string, logOptions model.LogFilter) (io.ReadCloser, error)
 	ContainerExec(ctx context.Context, id string, exeConf model.ExecConfig) (string, error)
+	GetContainerStats(ctx context.Context, id string) (model.ContainerStats, error)
+	GetContainerStatsStream(ctx context.Context, id string) (io.ReadCloser, error)
 	GetImages(ctx context.Context, filter model.ImageFilter) ([]model.Image, error)
 	GetImage(ctx context.Context, id string) (model.Image, error)
+	GetImageDetails(ctx context.Context, id string) (model.ImageDetails, error)
 	AddImage(ctx context.Context, img string) (jobId string, err error)
+	AddImageWithOptions(ctx context.Context, req model.ImageRequest) (jobId string, err error)
+	CheckImageUpdate(ctx context.Context, req model.ImageRequest) (model.ImageUpdateCheck, error)
 	RemoveImage(ctx context.Context, id string) error
-	GetNetworks(ctx context.Context) ([]model.Network, error)
+	TagImage(ctx context.Context, id, repo, tag string) error
+	UntagImage(ctx context.Context, id, repo, tag string) error
+	ImportImage(ctx context.Context, r io.Reader) (jobId string, err error)
+	ExportImage(ctx context.Context, id string) (io.ReadCloser, error)
+	GetNetworks(ctx context.Context, filter model.NetworkFilter) ([]model.Network, error)
 	GetNetwork(ctx context.Context, id string) (model.Network, error)
 	CreateNetwork(ctx context.Context, net model.Network) (string, error)
 	RemoveNetwork(ctx context.Context, id string) error
 	GetVolumes(ctx context.Context, filter model.VolumeFilter) ([]model.Volume, error)
 	GetVolume(ctx context.Context, id string) (model.Volume, error)
+	GetVolumeWithUsage(ctx context.Context, id string) (model.Volume, error)
 	CreateVolume(ctx context.Context, vol model.Volume) (string, error)
 	RemoveVolume(ctx context.Context, id string, force bool) error
+	GetEvents(ctx context.Context, filter model.EventFilter) (<-chan model.Event, <-chan error)
+	PruneContainers(ctx context.Context, filter model.PruneFilter) (jobId string, err error)
+	PruneImages(ctx context.Context, filter model.PruneFilter) (jobId string, err error)
+	PruneNetworks(ctx context.Context, filter model.PruneFilter) (jobId string, err error)
+	PruneVolumes(ctx context.Context, filter model.PruneFilter) (jobId string, err error)
+	PruneBuildCache(ctx context.Context, filter model.PruneFilter) (jobId string, err error)
 	job_hdl_lib.Api
 	srv_info_lib.Api
 }
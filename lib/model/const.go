@@ -22,6 +22,10 @@ const (
 	HeaderSrvName   = "X-Service"
 )
 
+const MIMEApplicationNDJSON = "application/x-ndjson"
+
+const MIMEApplicationTar = "application/x-tar"
+
 const (
 	TcpPort  PortType = "tcp"
 	UdpPort  PortType = "udp"
@@ -37,15 +41,43 @@ var PortTypeMap = map[PortType]struct{}{
 const (
 	BridgeNet  NetworkType = "bridge"
 	MACVlanNet NetworkType = "macvlan"
+	IPVlanNet  NetworkType = "ipvlan"
 	HostNet    NetworkType = "host"
 )
 
 var NetworkTypeMap = map[NetworkType]struct{}{
 	BridgeNet:  {},
 	MACVlanNet: {},
+	IPVlanNet:  {},
 	HostNet:    {},
 }
 
+const (
+	MACVlanBridgeMode   NetworkMode = "bridge"
+	MACVlanVEPAMode     NetworkMode = "vepa"
+	MACVlanPrivateMode  NetworkMode = "private"
+	MACVlanPassthruMode NetworkMode = "passthru"
+)
+
+var MACVlanModeMap = map[NetworkMode]struct{}{
+	MACVlanBridgeMode:   {},
+	MACVlanVEPAMode:     {},
+	MACVlanPrivateMode:  {},
+	MACVlanPassthruMode: {},
+}
+
+const (
+	IPVlanL2Mode  NetworkMode = "l2"
+	IPVlanL3Mode  NetworkMode = "l3"
+	IPVlanL3SMode NetworkMode = "l3s"
+)
+
+var IPVlanModeMap = map[NetworkMode]struct{}{
+	IPVlanL2Mode:  {},
+	IPVlanL3Mode:  {},
+	IPVlanL3SMode: {},
+}
+
 const (
 	RestartNever      RestartStrategy = "never"
 	RestartAlways     RestartStrategy = "always"
@@ -98,16 +130,68 @@ const (
 	TransitionState ContainerHealth = "transitioning"
 )
 
+const (
+	ImageUpToDate        ImageUpdateStatus = "up_to_date"
+	ImageUpdateAvailable ImageUpdateStatus = "update_available"
+	ImageNotLocal        ImageUpdateStatus = "not_local"
+)
+
+const (
+	StdoutStream LogStream = "stdout"
+	StderrStream LogStream = "stderr"
+)
+
+var LogStreamMap = map[LogStream]struct{}{
+	StdoutStream: {},
+	StderrStream: {},
+}
+
+const (
+	TextLogFormat   LogFormat = "text"
+	NDJSONLogFormat LogFormat = "ndjson"
+)
+
+var LogFormatMap = map[LogFormat]struct{}{
+	TextLogFormat:   {},
+	NDJSONLogFormat: {},
+}
+
+const (
+	ContainerEvent EventType = "container"
+	ImageEvent     EventType = "image"
+	VolumeEvent    EventType = "volume"
+	NetworkEvent   EventType = "network"
+)
+
+var EventTypeMap = map[EventType]struct{}{
+	ContainerEvent: {},
+	ImageEvent:     {},
+	VolumeEvent:    {},
+	NetworkEvent:   {},
+}
+
 const (
 	ContainersPath       = "containers"
 	ContainerStartPath   = "start"
 	ContainerStopPath    = "stop"
 	ContainerRestartPath = "restart"
+	ContainerPausePath   = "pause"
+	ContainerUnpausePath = "unpause"
 	ContainerLogsPath    = "logs"
 	ContainerExecPath    = "exec"
+	ContainerStatsPath   = "stats"
+	ContainerNetsPath    = "networks"
 	ImagesPath           = "images"
+	ImageImportPath      = "import"
+	ImageExportPath      = "export"
+	ImageTagsPath        = "tags"
+	ImageDetailsPath     = "details"
+	ImageUpdateCheckPath = "update-check"
 	NetworksPath         = "networks"
 	VolumesPath          = "volumes"
+	EventsPath           = "events"
+	BuildCachePath       = "build-cache"
+	PrunePath            = "prune"
 	JobsPath             = "jobs"
 	JobsCancelPath       = "cancel"
 	SrvInfoPath          = "info"
@@ -34,14 +34,71 @@ type Image struct {
 	Labels  map[string]string `json:"labels"`
 }
 
+// ImageDetails History is ordered newest layer first.
+type ImageDetails struct {
+	Image
+	OS         string             `json:"os"`
+	Variant    string             `json:"variant"`
+	Entrypoint []string           `json:"entrypoint"`
+	Cmd        []string           `json:"cmd"`
+	WorkingDir string             `json:"working_dir"`
+	User       string             `json:"user"`
+	EnvVars    map[string]string  `json:"env_vars"`
+	Ports      []Port             `json:"ports"`
+	Volumes    []string           `json:"volumes"`
+	History    []ImageHistoryItem `json:"history"`
+}
+
+// ImageHistoryItem ID is empty for layers not available locally.
+type ImageHistoryItem struct {
+	ID        string    `json:"id"`
+	Created   time.Time `json:"created"`
+	CreatedBy string    `json:"created_by"`
+	Size      int64     `json:"size"`
+	Comment   string    `json:"comment"`
+	Tags      []string  `json:"tags"`
+}
+
 type ImageFilter struct {
 	Name   string
 	Tag    string
 	Labels map[string]string
 }
 
+// ImageRequest Platform is optional and must be formatted as os/arch[/variant] (e.g. linux/arm/v7).
 type ImageRequest struct {
-	Image string `json:"image"`
+	Image    string        `json:"image"`
+	Platform string        `json:"platform,omitempty"`
+	Auth     *RegistryAuth `json:"auth,omitempty"`
+}
+
+// RegistryAuth holds credentials for a container registry. Either username and password or an identity token must be provided.
+type RegistryAuth struct {
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	IdentityToken string `json:"identity_token,omitempty"`
+}
+
+type ImageTagRequest struct {
+	Repository string `json:"repository"`
+	Tag        string `json:"tag"`
+}
+
+type ImageUpdateStatus = string
+
+type ImageUpdateCheck struct {
+	Image        string            `json:"image"`
+	Status       ImageUpdateStatus `json:"status"`
+	LocalDigest  string            `json:"local_digest"`
+	RemoteDigest string            `json:"remote_digest"`
+}
+
+type ImagePullProgress struct {
+	Status       string `json:"status"`
+	LayersTotal  int    `json:"layers_total"`
+	LayersDone   int    `json:"layers_done"`
+	BytesCurrent int64  `json:"bytes_current"`
+	BytesTotal   int64  `json:"bytes_total"`
 }
 
 // Network -------------------------------------------------------------------------------------
@@ -55,12 +112,43 @@ type Subnet struct {
 
 type NetworkType = string
 
+type NetworkMode = string
+
+// Network Subnet and Gateway reflect the first IPv4 IPAM config and are only used for creation if IPAM is empty.
+// If no subnet is provided the engine allocates one.
+// Parent (host interface) and Mode are only valid for macvlan and ipvlan networks, the engine defaults to bridge and l2 mode respectively.
+// Attachable defaults to true if not provided.
 type Network struct {
-	ID      string      `json:"id"`
-	Name    string      `json:"name"`
-	Type    NetworkType `json:"type"`
-	Subnet  Subnet      `json:"subnet"`
-	Gateway IPAddr      `json:"gateway"`
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	Type       NetworkType        `json:"type"`
+	Driver     string             `json:"driver"`
+	Subnet     Subnet             `json:"subnet"`
+	Gateway    IPAddr             `json:"gateway"`
+	EnableIPv6 bool               `json:"enable_ipv6"`
+	IPAM       []IPAMConfig       `json:"ipam"`
+	Parent     string             `json:"parent"`
+	Mode       NetworkMode        `json:"mode"`
+	Internal   bool               `json:"internal"`
+	Attachable *bool              `json:"attachable"`
+	Labels     map[string]string  `json:"labels"`
+	Containers []NetworkContainer `json:"containers"`
+}
+
+type NetworkContainer struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	IPAddress   IPAddr `json:"ip_address"`
+	IPv6Address IPAddr `json:"ipv6_address"`
+	MacAddress  string `json:"mac_address"`
+}
+
+// IPAMConfig IPRange, Gateway and AuxAddresses are optional and must be within Subnet.
+type IPAMConfig struct {
+	Subnet       Subnet            `json:"subnet"`
+	IPRange      Subnet            `json:"ip_range"`
+	Gateway      IPAddr            `json:"gateway"`
+	AuxAddresses map[string]IPAddr `json:"aux_addresses"`
 }
 
 type PortType = string
@@ -108,6 +196,25 @@ type RunConfig struct {
 	Command         []string        `json:"command"`
 }
 
+// Resources limits are not set if zero. Memory values are given in bytes, with MemorySwap being the total of memory and swap (-1 for unlimited swap).
+// CPUs limits the CPU quota as a (fractional) number of cores, CPUSet the cores a container may use (e.g. "0-2" or "0,1") and a PidsLimit of -1 means unlimited.
+type Resources struct {
+	Memory            int64    `json:"memory"`
+	MemoryReservation int64    `json:"memory_reservation"`
+	MemorySwap        int64    `json:"memory_swap"`
+	CPUs              float64  `json:"cpus"`
+	CPUShares         int64    `json:"cpu_shares"`
+	CPUSet            string   `json:"cpu_set"`
+	PidsLimit         int64    `json:"pids_limit"`
+	Ulimits           []Ulimit `json:"ulimits"`
+}
+
+type Ulimit struct {
+	Name string `json:"name"`
+	Soft int64  `json:"soft"`
+	Hard int64  `json:"hard"`
+}
+
 type ContainerState = string
 
 type ContainerHealth = string
@@ -129,14 +236,24 @@ type Container struct {
 	Ports             []Port            `json:"ports"`
 	Networks          []ContainerNet    `json:"networks"`
 	RunConfig         RunConfig         `json:"run_config"`
+	Resources         Resources         `json:"resources"`
 }
 
+// ContainerUpdate fields that are nil or zero leave the current configuration unchanged.
+type ContainerUpdate struct {
+	RestartStrategy *RestartStrategy `json:"restart_strategy"`
+	Retries         *int             `json:"retries"`
+	Resources       *Resources       `json:"resources"`
+}
+
+// ContainerNet IPAddress, IPv6Address and MacAddress are assigned by the engine if not provided, Gateway is read only.
 type ContainerNet struct {
 	ID          string   `json:"id"`
 	Name        string   `json:"name"`
 	DomainNames []string `json:"domain_names"`
 	Gateway     IPAddr   `json:"gateway"`
 	IPAddress   IPAddr   `json:"ip_address"`
+	IPv6Address IPAddr   `json:"ipv6_address"`
 	MacAddress  string   `json:"mac_address"`
 }
 
@@ -151,6 +268,19 @@ type LogFilter struct {
 	MaxLines int
 	Since    time.Time
 	Until    time.Time
+	Follow   bool
+	Stream   LogStream
+	Format   LogFormat
+}
+
+type LogStream = string
+
+type LogFormat = string
+
+type LogEntry struct {
+	Stream    LogStream `json:"stream"`
+	Timestamp time.Time `json:"timestamp"`
+	Line      string    `json:"line"`
 }
 
 type ExecConfig struct {
@@ -160,17 +290,94 @@ type ExecConfig struct {
 	Cmd     []string
 }
 
+type ExecResult struct {
+	ExitCode        int       `json:"exit_code"`
+	Stdout          string    `json:"stdout"`
+	StdoutTruncated bool      `json:"stdout_truncated"`
+	Stderr          string    `json:"stderr"`
+	StderrTruncated bool      `json:"stderr_truncated"`
+	Started         time.Time `json:"started"`
+	Finished        time.Time `json:"finished"`
+}
+
+type ContainerStats struct {
+	Time       time.Time               `json:"time"`
+	CPUPercent float64                 `json:"cpu_percent"`
+	Memory     MemoryStats             `json:"memory"`
+	Networks   map[string]NetworkStats `json:"networks"`
+	BlockIO    BlockIOStats            `json:"block_io"`
+	PIDs       uint64                  `json:"pids"`
+}
+
+type MemoryStats struct {
+	Usage   uint64  `json:"usage"`
+	Limit   uint64  `json:"limit"`
+	Percent float64 `json:"percent"`
+}
+
+type NetworkStats struct {
+	RxBytes uint64 `json:"rx_bytes"`
+	TxBytes uint64 `json:"tx_bytes"`
+}
+
+type BlockIOStats struct {
+	ReadBytes  uint64 `json:"read_bytes"`
+	WriteBytes uint64 `json:"write_bytes"`
+}
+
 // Volume --------------------------------------------------------------------------------------
 
+// Volume Usage is only provided if requested, as retrieving it requires the engine to scan all volumes.
 type Volume struct {
-	Name    string            `json:"name"`
-	Created time.Time         `json:"created"`
-	Labels  map[string]string `json:"labels"`
+	Name       string            `json:"name"`
+	Created    time.Time         `json:"created"`
+	Labels     map[string]string `json:"labels"`
+	Driver     string            `json:"driver"`
+	DriverOpts map[string]string `json:"driver_opts"`
+	Mountpoint string            `json:"mountpoint"`
+	Scope      string            `json:"scope"`
+	Usage      *VolumeUsage      `json:"usage"`
+}
+
+// VolumeUsage Size and RefCount are set to -1 if not available.
+type VolumeUsage struct {
+	Size     int64 `json:"size"`
+	RefCount int64 `json:"ref_count"`
 }
 
 type VolumeFilter struct {
 	Names  []string
 	Labels map[string]string
+	Usage  bool
+}
+
+type NetworkFilter struct {
+	Ids           []string
+	Names         []string
+	Type          NetworkType
+	Labels        map[string]string
+	SupportedOnly bool
+}
+
+// Event ---------------------------------------------------------------------------------------
+
+type EventType = string
+
+type Event struct {
+	Type       EventType         `json:"type"`
+	Action     string            `json:"action"`
+	ID         string            `json:"id"`
+	Name       string            `json:"name,omitempty"`
+	State      *ContainerState   `json:"state,omitempty"`
+	Health     *ContainerHealth  `json:"health,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Time       time.Time         `json:"time"`
+}
+
+type EventFilter struct {
+	Types  []EventType
+	Ids    []string
+	Labels map[string]string
 }
 
 // Error -----------------------------------------------------------------------------------------
@@ -190,3 +397,18 @@ type NotFoundError struct {
 type InvalidInputError struct {
 	cError
 }
+
+// Prune -------------------------------------------------------------------------------------
+
+// PruneFilter All removes all unused images instead of dangling ones only, includes named volumes and removes all build cache instead of dangling cache only.
+// It is not supported for containers and networks. Labels are not supported for build cache and OlderThan is not supported for volumes.
+type PruneFilter struct {
+	All       bool
+	Labels    map[string]string
+	OlderThan time.Duration
+}
+
+type PruneReport struct {
+	Removed        []string `json:"removed"`
+	SpaceReclaimed uint64   `json:"space_reclaimed"`
+}
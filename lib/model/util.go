@@ -38,6 +38,10 @@ func (s *Subnet) KeyStr() string {
 	return fmt.Sprintf("%s/%d", net.IP(s.Prefix).String(), s.Bits)
 }
 
+func (s *Subnet) IsZero() bool {
+	return len(s.Prefix) == 0 && s.Bits == 0
+}
+
 func (i *IPAddr) UnmarshalJSON(b []byte) (err error) {
 	var s string
 	if err = json.Unmarshal(b, &s); err != nil {
@@ -89,7 +89,7 @@ func main() {
 		Driver:  config.Docker.CtrLogDriver,
 		MaxSize: config.Docker.CtrLogMaxSize,
 		MaxFile: config.Docker.CtrLogMaxFile,
-	})
+	}, util.GetRegistryAuths(config.Docker.RegistryAuths))
 	if err != nil {
 		util.Logger.Error(err)
 		ec = 1
@@ -127,12 +127,12 @@ func main() {
 		return nil
 	})
 
-	cew := wrapper.New(dockerHandler, jobHandler, srvInfoHdl)
+	cew := wrapper.New(dockerHandler, jobHandler, srvInfoHdl, config.Http.ImgImportDir)
 
 	httpHandler, err := http_hdl.New(cew, map[string]string{
 		model.HeaderApiVer:  srvInfoHdl.GetVersion(),
 		model.HeaderSrvName: srvInfoHdl.GetName(),
-	})
+	}, config.Http.ImgImportMaxSize)
 	if err != nil {
 		util.Logger.Error(err)
 		ec = 1
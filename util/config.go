@@ -18,7 +18,9 @@ package util
 
 import (
 	"github.com/SENERGY-Platform/go-service-base/config-hdl"
+	"github.com/SENERGY-Platform/go-service-base/config-hdl/types"
 	sb_logger "github.com/SENERGY-Platform/go-service-base/logger"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	envldr "github.com/y-du/go-env-loader"
 	"github.com/y-du/go-log-level/level"
 	"io/fs"
@@ -51,11 +53,24 @@ type LoggerConfig struct {
 	Prefix       string      `json:"prefix" env_var:"LOGGER_PREFIX"`
 }
 
+type RegistryAuthConfig struct {
+	Username      string       `json:"username"`
+	Password      types.Secret `json:"password"`
+	IdentityToken types.Secret `json:"identity_token"`
+}
+
 type DockerConfig struct {
-	Host          string `json:"host" env_var:"DOCKER_HOST"`
-	CtrLogDriver  string `json:"ctr_log_driver" env_var:"DOCKER_CTR_LOG_DRIVER"`
-	CtrLogMaxSize string `json:"ctr_log_max_size" env_var:"DOCKER_CTR_LOG_MAX_SIZE"`
-	CtrLogMaxFile int    `json:"ctr_log_max_file" env_var:"DOCKER_CTR_LOG_MAX_FILE"`
+	Host          string                        `json:"host" env_var:"DOCKER_HOST"`
+	CtrLogDriver  string                        `json:"ctr_log_driver" env_var:"DOCKER_CTR_LOG_DRIVER"`
+	CtrLogMaxSize string                        `json:"ctr_log_max_size" env_var:"DOCKER_CTR_LOG_MAX_SIZE"`
+	CtrLogMaxFile int                           `json:"ctr_log_max_file" env_var:"DOCKER_CTR_LOG_MAX_FILE"`
+	RegistryAuths map[string]RegistryAuthConfig `json:"registry_auths" env_var:"DOCKER_REGISTRY_AUTHS"`
+}
+
+// HttpConfig ImgImportDir is used to buffer uploaded image archives until they are loaded, defaults to the system's temporary directory.
+type HttpConfig struct {
+	ImgImportMaxSize int64  `json:"img_import_max_size" env_var:"HTTP_IMG_IMPORT_MAX_SIZE"`
+	ImgImportDir     string `json:"img_import_dir" env_var:"HTTP_IMG_IMPORT_DIR"`
 }
 
 type Config struct {
@@ -63,6 +78,7 @@ type Config struct {
 	Socket SocketConfig `json:"socket" env_var:"SOCKET_CONFIG"`
 	Jobs   JobsConfig   `json:"jobs" env_var:"JOBS_CONFIG"`
 	Docker DockerConfig `json:"docker" env_var:"DOCKER_CONFIG"`
+	Http   HttpConfig   `json:"http" env_var:"HTTP_CONFIG"`
 }
 
 func NewConfig(path string) (*Config, error) {
@@ -90,7 +106,22 @@ func NewConfig(path string) (*Config, error) {
 		Docker: DockerConfig{
 			Host: "unix:///var/run/docker.sock",
 		},
+		Http: HttpConfig{
+			ImgImportMaxSize: 1073741824,
+		},
 	}
 	err := config_hdl.Load(&cfg, nil, map[reflect.Type]envldr.Parser{reflect.TypeOf(level.Off): sb_logger.LevelParser}, nil, path)
 	return &cfg, err
 }
+
+func GetRegistryAuths(c map[string]RegistryAuthConfig) map[string]model.RegistryAuth {
+	auths := make(map[string]model.RegistryAuth)
+	for host, rac := range c {
+		auths[host] = model.RegistryAuth{
+			Username:      rac.Username,
+			Password:      rac.Password.Value(),
+			IdentityToken: rac.IdentityToken.Value(),
+		}
+	}
+	return auths
+}
@@ -35,6 +35,10 @@ func (a *Wrapper) CreateContainer(ctx context.Context, container model.Container
 	return a.ceHandler.ContainerCreate(ctx, container)
 }
 
+func (a *Wrapper) UpdateContainer(ctx context.Context, id string, update model.ContainerUpdate) error {
+	return a.ceHandler.ContainerUpdate(ctx, id, update)
+}
+
 func (a *Wrapper) StartContainer(ctx context.Context, id string) error {
 	return a.ceHandler.ContainerStart(ctx, id)
 }
@@ -61,6 +65,22 @@ func (a *Wrapper) RestartContainer(ctx context.Context, id string) (string, erro
 	})
 }
 
+func (a *Wrapper) PauseContainer(ctx context.Context, id string) error {
+	return a.ceHandler.ContainerPause(ctx, id)
+}
+
+func (a *Wrapper) UnpauseContainer(ctx context.Context, id string) error {
+	return a.ceHandler.ContainerUnpause(ctx, id)
+}
+
+func (a *Wrapper) ConnectContainerNetwork(ctx context.Context, id string, net model.ContainerNet) error {
+	return a.ceHandler.ContainerNetConnect(ctx, id, net)
+}
+
+func (a *Wrapper) DisconnectContainerNetwork(ctx context.Context, id, netID string, force bool) error {
+	return a.ceHandler.ContainerNetDisconnect(ctx, id, netID, force)
+}
+
 func (a *Wrapper) RemoveContainer(ctx context.Context, id string, force bool) error {
 	return a.ceHandler.ContainerRemove(ctx, id, force)
 }
@@ -72,10 +92,18 @@ func (a *Wrapper) GetContainerLog(ctx context.Context, id string, logOptions mod
 func (a *Wrapper) ContainerExec(ctx context.Context, id string, exeConf model.ExecConfig) (string, error) {
 	return a.jobHandler.Create(ctx, fmt.Sprintf("container execute '%+v'", exeConf), func(ctx context.Context, cf context.CancelFunc) (any, error) {
 		defer cf()
-		err := a.ceHandler.ContainerExec(ctx, id, exeConf)
+		res, err := a.ceHandler.ContainerExec(ctx, id, exeConf)
 		if err == nil {
 			err = ctx.Err()
 		}
-		return nil, err
+		return res, err
 	})
 }
+
+func (a *Wrapper) GetContainerStats(ctx context.Context, id string) (model.ContainerStats, error) {
+	return a.ceHandler.ContainerStats(ctx, id)
+}
+
+func (a *Wrapper) GetContainerStatsStream(ctx context.Context, id string) (io.ReadCloser, error) {
+	return a.ceHandler.ContainerStatsStream(ctx, id)
+}
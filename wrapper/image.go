@@ -20,6 +20,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"io"
+	"os"
+	"sync"
 )
 
 func (a *Wrapper) GetImages(ctx context.Context, filter model.ImageFilter) ([]model.Image, error) {
@@ -30,17 +33,78 @@ func (a *Wrapper) GetImage(ctx context.Context, id string) (model.Image, error)
 	return a.ceHandler.ImageInfo(ctx, id)
 }
 
+func (a *Wrapper) GetImageDetails(ctx context.Context, id string) (model.ImageDetails, error) {
+	return a.ceHandler.ImageDetails(ctx, id)
+}
+
 func (a *Wrapper) AddImage(ctx context.Context, img string) (string, error) {
-	return a.jobHandler.Create(ctx, fmt.Sprintf("add image '%s'", img), func(ctx context.Context, cf context.CancelFunc) (any, error) {
+	return a.AddImageWithOptions(ctx, model.ImageRequest{Image: img})
+}
+
+func (a *Wrapper) AddImageWithOptions(ctx context.Context, req model.ImageRequest) (string, error) {
+	var mu sync.RWMutex
+	var progress model.ImagePullProgress
+	getProgress := func() any {
+		mu.RLock()
+		defer mu.RUnlock()
+		return progress
+	}
+	return a.createJobWithProgress(ctx, fmt.Sprintf("add image '%s'", req.Image), func(ctx context.Context, cf context.CancelFunc) (any, error) {
 		defer cf()
-		err := a.ceHandler.ImagePull(ctx, img)
+		err := a.ceHandler.ImagePull(ctx, req, func(p model.ImagePullProgress) {
+			mu.Lock()
+			progress = p
+			mu.Unlock()
+		})
 		if err == nil {
 			err = ctx.Err()
 		}
-		return nil, err
-	})
+		return getProgress(), err
+	}, getProgress)
+}
+
+func (a *Wrapper) CheckImageUpdate(ctx context.Context, req model.ImageRequest) (model.ImageUpdateCheck, error) {
+	return a.ceHandler.ImageCheckUpdate(ctx, req)
 }
 
 func (a *Wrapper) RemoveImage(ctx context.Context, id string) error {
 	return a.ceHandler.ImageRemove(ctx, id)
 }
+
+func (a *Wrapper) TagImage(ctx context.Context, id, repo, tag string) error {
+	return a.ceHandler.ImageTag(ctx, id, repo, tag)
+}
+
+func (a *Wrapper) UntagImage(ctx context.Context, id, repo, tag string) error {
+	return a.ceHandler.ImageUntag(ctx, id, repo, tag)
+}
+
+func (a *Wrapper) ImportImage(ctx context.Context, r io.Reader) (string, error) {
+	f, err := os.CreateTemp(a.imgImportDir, "image-import-*.tar")
+	if err != nil {
+		return "", model.NewInternalError(err)
+	}
+	cleanup := sync.OnceFunc(func() {
+		f.Close()
+		os.Remove(f.Name())
+	})
+	if _, err = io.Copy(f, r); err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		cleanup()
+		return "", model.NewInternalError(err)
+	}
+	return a.createJobWithCleanup(ctx, "import image", func(ctx context.Context, cf context.CancelFunc) (any, error) {
+		defer cf()
+		images, err := a.ceHandler.ImageLoad(ctx, f)
+		if err == nil {
+			err = ctx.Err()
+		}
+		return images, err
+	}, cleanup)
+}
+
+func (a *Wrapper) ExportImage(ctx context.Context, id string) (io.ReadCloser, error) {
+	return a.ceHandler.ImageSave(ctx, id)
+}
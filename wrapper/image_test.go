@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wrapper
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+type testCeHandler struct {
+	ContainerEngineHandler
+	loaded string
+}
+
+func (h *testCeHandler) ImageLoad(_ context.Context, r io.Reader) ([]string, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	h.loaded = string(b)
+	return []string{"image"}, nil
+}
+
+type testErrReader struct{}
+
+func (testErrReader) Read(_ []byte) (int, error) {
+	return 0, errors.New("test error")
+}
+
+func TestWrapper_ImportImage(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      io.Reader
+		end        func(t *testing.T, a *Wrapper, h *testJobHandler, id string)
+		wantErr    bool
+		wantLoaded bool
+	}{
+		{
+			name:  "loaded",
+			input: strings.NewReader("archive"),
+			end: func(t *testing.T, a *Wrapper, h *testJobHandler, id string) {
+				h.run(id)
+			},
+			wantLoaded: true,
+		},
+		{
+			name:  "canceled while pending",
+			input: strings.NewReader("archive"),
+			end: func(t *testing.T, a *Wrapper, h *testJobHandler, id string) {
+				if err := a.CancelJob(context.Background(), id); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+		{
+			name:  "purged while pending",
+			input: strings.NewReader("archive"),
+			end: func(t *testing.T, a *Wrapper, h *testJobHandler, id string) {
+				h.purge(id)
+				if _, err := a.GetJob(context.Background(), id); err == nil {
+					t.Fatal("expected error")
+				}
+			},
+		},
+		{
+			name:    "upload failed",
+			input:   testErrReader{},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			h := &testJobHandler{}
+			ce := &testCeHandler{}
+			a := New(ce, h, nil, dir)
+			id, err := a.ImportImage(context.Background(), tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+					t.Fatalf("expected 1 buffered archive, got %d", len(entries))
+				}
+				tc.end(t, a, h, id)
+			}
+			if tc.wantLoaded && ce.loaded != "archive" {
+				t.Errorf("expected 'archive' to be loaded, got '%s'", ce.loaded)
+			}
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != 0 {
+				t.Errorf("expected no buffered archives, got %d", len(entries))
+			}
+		})
+	}
+}
@@ -23,7 +23,7 @@ import (
 )
 
 type ContainerEngineHandler interface {
-	ListNetworks(ctx context.Context) ([]model.Network, error)
+	ListNetworks(ctx context.Context, filter model.NetworkFilter) ([]model.Network, error)
 	ListContainers(ctx context.Context, filter model.ContainerFilter) ([]model.Container, error)
 	ListImages(ctx context.Context, filter model.ImageFilter) ([]model.Image, error)
 	ListVolumes(ctx context.Context, filter model.VolumeFilter) ([]model.Volume, error)
@@ -32,16 +32,35 @@ type ContainerEngineHandler interface {
 	NetworkRemove(ctx context.Context, id string) error
 	ContainerInfo(ctx context.Context, id string) (model.Container, error)
 	ContainerCreate(ctx context.Context, container model.Container) (id string, err error)
+	ContainerUpdate(ctx context.Context, id string, update model.ContainerUpdate) error
+	ContainerNetConnect(ctx context.Context, id string, net model.ContainerNet) error
+	ContainerNetDisconnect(ctx context.Context, id, netID string, force bool) error
 	ContainerRemove(ctx context.Context, id string, force bool) error
 	ContainerStart(ctx context.Context, id string) error
 	ContainerStop(ctx context.Context, id string) error
 	ContainerRestart(ctx context.Context, id string) error
+	ContainerPause(ctx context.Context, id string) error
+	ContainerUnpause(ctx context.Context, id string) error
 	ContainerLog(ctx context.Context, id string, logOptions model.LogFilter) (io.ReadCloser, error)
-	ContainerExec(ctx context.Context, id string, execOpt model.ExecConfig) error
+	ContainerExec(ctx context.Context, id string, execOpt model.ExecConfig) (model.ExecResult, error)
+	ContainerStats(ctx context.Context, id string) (model.ContainerStats, error)
+	ContainerStatsStream(ctx context.Context, id string) (io.ReadCloser, error)
 	ImageInfo(ctx context.Context, id string) (model.Image, error)
-	ImagePull(ctx context.Context, id string) error
+	ImageDetails(ctx context.Context, id string) (model.ImageDetails, error)
+	ImagePull(ctx context.Context, req model.ImageRequest, progressFunc func(model.ImagePullProgress)) error
+	ImageCheckUpdate(ctx context.Context, req model.ImageRequest) (model.ImageUpdateCheck, error)
 	ImageRemove(ctx context.Context, id string) error
-	VolumeInfo(ctx context.Context, id string) (model.Volume, error)
+	ImageTag(ctx context.Context, id, repo, tag string) error
+	ImageUntag(ctx context.Context, id, repo, tag string) error
+	ImageLoad(ctx context.Context, r io.Reader) ([]string, error)
+	ImageSave(ctx context.Context, id string) (io.ReadCloser, error)
+	VolumeInfo(ctx context.Context, id string, usage bool) (model.Volume, error)
 	VolumeCreate(ctx context.Context, vol model.Volume) (string, error)
 	VolumeRemove(ctx context.Context, id string, force bool) error
+	Events(ctx context.Context, filter model.EventFilter) (<-chan model.Event, <-chan error)
+	PruneContainers(ctx context.Context, filter model.PruneFilter) (model.PruneReport, error)
+	PruneImages(ctx context.Context, filter model.PruneFilter) (model.PruneReport, error)
+	PruneNetworks(ctx context.Context, filter model.PruneFilter) (model.PruneReport, error)
+	PruneVolumes(ctx context.Context, filter model.PruneFilter) (model.PruneReport, error)
+	PruneBuildCache(ctx context.Context, filter model.PruneFilter) (model.PruneReport, error)
 }
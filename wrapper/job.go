@@ -18,17 +18,104 @@ package wrapper
 
 import (
 	"context"
+	"errors"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	job_hdl_lib "github.com/SENERGY-Platform/mgw-go-service-base/job-hdl/lib"
 )
 
 func (a *Wrapper) GetJobs(ctx context.Context, filter job_hdl_lib.JobFilter) ([]job_hdl_lib.Job, error) {
-	return a.jobHandler.List(ctx, filter)
+	jobs, err := a.jobHandler.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobs {
+		a.setJobProgress(&jobs[i])
+	}
+	return jobs, nil
 }
 
 func (a *Wrapper) GetJob(ctx context.Context, id string) (job_hdl_lib.Job, error) {
-	return a.jobHandler.Get(ctx, id)
+	job, err := a.jobHandler.Get(ctx, id)
+	if err != nil {
+		var nfErr *model.NotFoundError
+		if errors.As(err, &nfErr) {
+			a.releaseJob(id)
+		}
+		return job_hdl_lib.Job{}, err
+	}
+	a.setJobProgress(&job)
+	return job, nil
 }
 
 func (a *Wrapper) CancelJob(ctx context.Context, id string) error {
-	return a.jobHandler.Cancel(ctx, id)
+	if err := a.jobHandler.Cancel(ctx, id); err != nil {
+		return err
+	}
+	a.releaseJob(id)
+	return nil
+}
+
+// createJobWithProgress creates a job that provides the value of progressFunc as its result while running.
+// The progress entry is removed once the job function returns, the job is canceled or a completed job is retrieved.
+func (a *Wrapper) createJobWithProgress(ctx context.Context, desc string, tFunc func(context.Context, context.CancelFunc) (any, error), progressFunc func() any) (string, error) {
+	idChan := make(chan string, 1)
+	jID, err := a.jobHandler.Create(ctx, desc, func(ctx context.Context, cf context.CancelFunc) (any, error) {
+		defer func() {
+			if id, ok := <-idChan; ok {
+				a.releaseJob(id)
+			}
+		}()
+		return tFunc(ctx, cf)
+	})
+	if err != nil {
+		close(idChan)
+		return "", err
+	}
+	a.mu.Lock()
+	a.jobProgress[jID] = progressFunc
+	a.mu.Unlock()
+	idChan <- jID
+	return jID, nil
+}
+
+// createJobWithCleanup creates a job and calls cleanupFunc once the job function returns, the job is canceled or
+// a job that never ran is no longer available. cleanupFunc is also called if the job can't be created and may run more than once.
+func (a *Wrapper) createJobWithCleanup(ctx context.Context, desc string, tFunc func(context.Context, context.CancelFunc) (any, error), cleanupFunc func()) (string, error) {
+	jID, err := a.jobHandler.Create(ctx, desc, func(ctx context.Context, cf context.CancelFunc) (any, error) {
+		defer cleanupFunc()
+		return tFunc(ctx, cf)
+	})
+	if err != nil {
+		cleanupFunc()
+		return "", err
+	}
+	a.mu.Lock()
+	a.jobCleanup[jID] = cleanupFunc
+	a.mu.Unlock()
+	return jID, nil
+}
+
+func (a *Wrapper) setJobProgress(job *job_hdl_lib.Job) {
+	if job.Completed != nil || job.Canceled != nil {
+		a.releaseJob(job.ID)
+		return
+	}
+	a.mu.RLock()
+	progressFunc, ok := a.jobProgress[job.ID]
+	a.mu.RUnlock()
+	if ok {
+		job.Result = progressFunc()
+	}
+}
+
+// releaseJob removes the progress entry of a job and runs its cleanup function.
+func (a *Wrapper) releaseJob(id string) {
+	a.mu.Lock()
+	delete(a.jobProgress, id)
+	cleanup, ok := a.jobCleanup[id]
+	delete(a.jobCleanup, id)
+	a.mu.Unlock()
+	if ok {
+		cleanup()
+	}
 }
@@ -0,0 +1,176 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wrapper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/SENERGY-Platform/mgw-go-service-base/job-hdl"
+	job_hdl_lib "github.com/SENERGY-Platform/mgw-go-service-base/job-hdl/lib"
+)
+
+type testJob struct {
+	job   job_hdl_lib.Job
+	tFunc func(context.Context, context.CancelFunc) (any, error)
+}
+
+// testJobHandler stores jobs without running them, jobs are executed via run.
+type testJobHandler struct {
+	job_hdl.JobHandler
+	jobs map[string]*testJob
+	mu   sync.Mutex
+}
+
+func (h *testJobHandler) Create(_ context.Context, desc string, tFunc func(context.Context, context.CancelFunc) (any, error)) (string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.jobs == nil {
+		h.jobs = make(map[string]*testJob)
+	}
+	id := strconv.Itoa(len(h.jobs))
+	h.jobs[id] = &testJob{job: job_hdl_lib.Job{ID: id, Description: desc, Created: time.Now()}, tFunc: tFunc}
+	return id, nil
+}
+
+func (h *testJobHandler) List(_ context.Context, _ job_hdl_lib.JobFilter) ([]job_hdl_lib.Job, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var jobs []job_hdl_lib.Job
+	for _, j := range h.jobs {
+		jobs = append(jobs, j.job)
+	}
+	return jobs, nil
+}
+
+func (h *testJobHandler) Get(_ context.Context, id string) (job_hdl_lib.Job, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	j, ok := h.jobs[id]
+	if !ok {
+		return job_hdl_lib.Job{}, model.NewNotFoundError(errors.New("not found"))
+	}
+	return j.job, nil
+}
+
+func (h *testJobHandler) Cancel(_ context.Context, id string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	j, ok := h.jobs[id]
+	if !ok {
+		return model.NewNotFoundError(errors.New("not found"))
+	}
+	t := time.Now()
+	j.job.Canceled = &t
+	return nil
+}
+
+func (h *testJobHandler) run(id string) {
+	h.mu.Lock()
+	j := h.jobs[id]
+	h.mu.Unlock()
+	ctx, cf := context.WithCancel(context.Background())
+	res, _ := j.tFunc(ctx, cf)
+	t := time.Now()
+	h.mu.Lock()
+	j.job.Result = res
+	j.job.Completed = &t
+	h.mu.Unlock()
+}
+
+func (h *testJobHandler) purge(id string) {
+	h.mu.Lock()
+	delete(h.jobs, id)
+	h.mu.Unlock()
+}
+
+func TestWrapper_createJobWithProgress(t *testing.T) {
+	tests := []struct {
+		name string
+		end  func(t *testing.T, a *Wrapper, h *testJobHandler, id string)
+	}{
+		{
+			name: "completed",
+			end: func(t *testing.T, a *Wrapper, h *testJobHandler, id string) {
+				h.run(id)
+			},
+		},
+		{
+			name: "canceled while pending",
+			end: func(t *testing.T, a *Wrapper, h *testJobHandler, id string) {
+				if err := a.CancelJob(context.Background(), id); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+		{
+			name: "purged while pending",
+			end: func(t *testing.T, a *Wrapper, h *testJobHandler, id string) {
+				h.purge(id)
+				if _, err := a.GetJob(context.Background(), id); err == nil {
+					t.Fatal("expected error")
+				}
+			},
+		},
+		{
+			name: "completed by other means",
+			end: func(t *testing.T, a *Wrapper, h *testJobHandler, id string) {
+				tc := time.Now()
+				h.mu.Lock()
+				h.jobs[id].job.Completed = &tc
+				h.mu.Unlock()
+				if _, err := a.GetJobs(context.Background(), job_hdl_lib.JobFilter{}); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := &testJobHandler{}
+			a := New(nil, h, nil, "")
+			id, err := a.createJobWithProgress(context.Background(), "test", func(ctx context.Context, cf context.CancelFunc) (any, error) {
+				defer cf()
+				return "done", nil
+			}, func() any {
+				return "progress"
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			job, err := a.GetJob(context.Background(), id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if job.Result != "progress" {
+				t.Errorf("expected progress as result, got %v", job.Result)
+			}
+			tc.end(t, a, h, id)
+			a.mu.RLock()
+			n := len(a.jobProgress)
+			a.mu.RUnlock()
+			if n != 0 {
+				t.Errorf("expected no progress entries, got %d", n)
+			}
+		})
+	}
+}
@@ -21,8 +21,8 @@ import (
 	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 )
 
-func (a *Wrapper) GetNetworks(ctx context.Context) ([]model.Network, error) {
-	return a.ceHandler.ListNetworks(ctx)
+func (a *Wrapper) GetNetworks(ctx context.Context, filter model.NetworkFilter) ([]model.Network, error) {
+	return a.ceHandler.ListNetworks(ctx, filter)
 }
 
 func (a *Wrapper) GetNetwork(ctx context.Context, id string) (model.Network, error) {
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wrapper
+
+import (
+	"context"
+	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+)
+
+func (a *Wrapper) PruneContainers(ctx context.Context, filter model.PruneFilter) (string, error) {
+	return a.createPruneJob(ctx, "containers", filter, a.ceHandler.PruneContainers)
+}
+
+func (a *Wrapper) PruneImages(ctx context.Context, filter model.PruneFilter) (string, error) {
+	return a.createPruneJob(ctx, "images", filter, a.ceHandler.PruneImages)
+}
+
+func (a *Wrapper) PruneNetworks(ctx context.Context, filter model.PruneFilter) (string, error) {
+	return a.createPruneJob(ctx, "networks", filter, a.ceHandler.PruneNetworks)
+}
+
+func (a *Wrapper) PruneVolumes(ctx context.Context, filter model.PruneFilter) (string, error) {
+	return a.createPruneJob(ctx, "volumes", filter, a.ceHandler.PruneVolumes)
+}
+
+func (a *Wrapper) PruneBuildCache(ctx context.Context, filter model.PruneFilter) (string, error) {
+	return a.createPruneJob(ctx, "build cache", filter, a.ceHandler.PruneBuildCache)
+}
+
+func (a *Wrapper) createPruneJob(ctx context.Context, kind string, filter model.PruneFilter, pFunc func(context.Context, model.PruneFilter) (model.PruneReport, error)) (string, error) {
+	return a.jobHandler.Create(ctx, "prune "+kind, func(ctx context.Context, cf context.CancelFunc) (any, error) {
+		defer cf()
+		report, err := pFunc(ctx, filter)
+		if err == nil {
+			err = ctx.Err()
+		}
+		return report, err
+	})
+}
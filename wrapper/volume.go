@@ -30,7 +30,11 @@ func (a *Wrapper) CreateVolume(ctx context.Context, vol model.Volume) (string, e
 }
 
 func (a *Wrapper) GetVolume(ctx context.Context, id string) (model.Volume, error) {
-	return a.ceHandler.VolumeInfo(ctx, id)
+	return a.ceHandler.VolumeInfo(ctx, id, false)
+}
+
+func (a *Wrapper) GetVolumeWithUsage(ctx context.Context, id string) (model.Volume, error) {
+	return a.ceHandler.VolumeInfo(ctx, id, true)
 }
 
 func (a *Wrapper) RemoveVolume(ctx context.Context, id string, force bool) error {
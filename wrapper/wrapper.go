@@ -19,18 +19,26 @@ package wrapper
 import (
 	"github.com/SENERGY-Platform/mgw-go-service-base/job-hdl"
 	"github.com/SENERGY-Platform/mgw-go-service-base/srv-info-hdl"
+	"sync"
 )
 
 type Wrapper struct {
-	ceHandler  ContainerEngineHandler
-	jobHandler job_hdl.JobHandler
-	srvInfoHdl srv_info_hdl.SrvInfoHandler
+	ceHandler    ContainerEngineHandler
+	jobHandler   job_hdl.JobHandler
+	srvInfoHdl   srv_info_hdl.SrvInfoHandler
+	imgImportDir string
+	jobProgress  map[string]func() any
+	jobCleanup   map[string]func()
+	mu           sync.RWMutex
 }
 
-func New(ceHandler ContainerEngineHandler, jobHandler job_hdl.JobHandler, srvInfoHandler srv_info_hdl.SrvInfoHandler) *Wrapper {
+func New(ceHandler ContainerEngineHandler, jobHandler job_hdl.JobHandler, srvInfoHandler srv_info_hdl.SrvInfoHandler, imgImportDir string) *Wrapper {
 	return &Wrapper{
-		ceHandler:  ceHandler,
-		jobHandler: jobHandler,
-		srvInfoHdl: srvInfoHandler,
+		ceHandler:    ceHandler,
+		jobHandler:   jobHandler,
+		srvInfoHdl:   srvInfoHandler,
+		imgImportDir: imgImportDir,
+		jobProgress:  make(map[string]func() any),
+		jobCleanup:   make(map[string]func()),
 	}
 }